@@ -0,0 +1,76 @@
+package bankofcanada
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+	"github.com/th3oth3rjak3/MoneyConverter/ratefeed"
+)
+
+// The errors of a call to the Valet API are those of the ratefeed package.
+const (
+	// ErrCallingServer returned when an error occurs while calling the server to retrieve exchange rates.
+	ErrCallingServer = ratefeed.ErrCallingServer
+	// ErrClientSide returned when a malformed client-side requests results in a 400 series error.
+	ErrClientSide = ratefeed.ErrClientSide
+	// ErrServerSide returned when a server malfunction occurs and returns a 500 series error.
+	ErrServerSide = ratefeed.ErrServerSide
+	// ErrUnknownStatusCode returned when any other status code is returned.
+	ErrUnknownStatusCode = ratefeed.ErrUnknownStatusCode
+)
+
+const (
+	// defaultBaseURL is the root of the Bank of Canada Valet API.
+	defaultBaseURL = "https://www.bankofcanada.ca/valet"
+	// dailyRatesPath returns the latest observation of every daily exchange rate series.
+	dailyRatesPath = "/observations/group/FX_RATES_DAILY/json?recent=1"
+)
+
+// BankOfCanada represents a structure that can call the Valet API to get exchange rates.
+type BankOfCanada struct {
+	baseURL string
+}
+
+// New creates a BankOfCanada that calls the Valet API at baseURL.
+// An empty baseURL uses the public Valet API.
+func New(baseURL string) BankOfCanada {
+	return BankOfCanada{baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// FetchExchangeRate gets the exchange rate for the source to target currency.
+func (boc BankOfCanada) FetchExchangeRate(source, target money.Currency) (money.ExchangeRate, error) {
+	table, err := boc.FetchRateTable()
+	if err != nil {
+		return 0., err
+	}
+
+	rate, err := table.FetchExchangeRate(source, target)
+	if err != nil {
+		return 0., fmt.Errorf("%w: %s", ErrExchangeRateNotFound, err)
+	}
+
+	return rate, nil
+}
+
+// FetchRateTable gets the latest daily exchange rates, quoted against the Canadian Dollar.
+func (boc BankOfCanada) FetchRateTable() (money.RateTable, error) {
+	baseURL := boc.baseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	var table money.RateTable
+
+	err := ratefeed.Get(baseURL+dailyRatesPath, func(body io.Reader) error {
+		var err error
+		table, err = readRateTableFromResponse(body)
+		return err
+	})
+	if err != nil {
+		return money.RateTable{}, err
+	}
+
+	return table, nil
+}
@@ -0,0 +1,130 @@
+package bankofcanada
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+func mustParseCurrency(t *testing.T, currency string) money.Currency {
+	t.Helper()
+
+	curr, err := money.ParseCurrency(currency)
+	if err != nil {
+		t.Fatalf("could not parse currency: %s", err.Error())
+	}
+
+	return curr
+}
+
+func TestBankOfCanada_FetchExchangeRate_Success(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/valet/observations/group/FX_RATES_DAILY/json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprintln(
+			w,
+			`{
+				"terms": {"url": "https://www.bankofcanada.ca/terms/"},
+				"seriesDetail": {
+					"FXUSDCAD": {"label": "USD/CAD", "description": "US dollar to Canadian dollar daily exchange rate"},
+					"FXEURCAD": {"label": "EUR/CAD", "description": "European euro to Canadian dollar daily exchange rate"}
+				},
+				"observations": [
+					{"d": "2024-06-20", "FXUSDCAD": {"v": "1.3692"}, "FXEURCAD": {"v": "1.4700"}}
+				]
+			}`)
+	}))
+
+	defer ts.Close()
+
+	boc := New(ts.URL + "/valet/")
+
+	type testCase struct {
+		source string
+		target string
+		want   money.ExchangeRate
+	}
+
+	testCases := map[string]testCase{
+		"foreign to CAD": {
+			source: "USD",
+			target: "CAD",
+			want:   1.3692,
+		},
+		"CAD to foreign": {
+			source: "CAD",
+			target: "EUR",
+			want:   1 / 1.4700,
+		},
+		"cross rate": {
+			source: "EUR",
+			target: "USD",
+			want:   1.4700 / 1.3692,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := boc.FetchExchangeRate(mustParseCurrency(t, tc.source), mustParseCurrency(t, tc.target))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !almostEqual(float64(got), float64(tc.want)) {
+				t.Errorf("got: %g, want: %g", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBankOfCanada_FetchExchangeRate_NotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"observations": [{"d": "2024-06-20", "FXUSDCAD": {"v": "1.3692"}}]}`)
+	}))
+
+	defer ts.Close()
+
+	boc := New(ts.URL)
+
+	_, err := boc.FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "JPY"))
+	if !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("got: %v, want: %s", err, ErrExchangeRateNotFound.Error())
+	}
+}
+
+func TestBankOfCanada_FetchExchangeRate_ServerError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	defer ts.Close()
+
+	boc := New(ts.URL)
+
+	_, err := boc.FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "CAD"))
+	if !errors.Is(err, ErrServerSide) {
+		t.Errorf("got: %v, want: %s", err, ErrServerSide.Error())
+	}
+}
+
+func TestBankOfCanada_FetchExchangeRate_ClientError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	defer ts.Close()
+
+	boc := New(ts.URL)
+
+	_, err := boc.FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "CAD"))
+	if !errors.Is(err, ErrClientSide) {
+		t.Errorf("got: %v, want: %s", err, ErrClientSide.Error())
+	}
+}
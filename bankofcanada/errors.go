@@ -0,0 +1,9 @@
+package bankofcanada
+
+// bocError defines a sentinel error.
+type bocError string
+
+// bocError implements the error interface.
+func (e bocError) Error() string {
+	return string(e)
+}
@@ -0,0 +1,97 @@
+package bankofcanada
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+// the Valet exchange rate series are all quoted in Canadian Dollars.
+const baseCurrencyCode = "CAD"
+
+const (
+	errSeriesNotExchangeRate = bocError("series is not an exchange rate")
+	errMissingObservations   = bocError("response has no observations")
+	ErrUnexpectedFormat      = bocError("response body was not in the expected format")
+	ErrExchangeRateNotFound  = bocError("exchange rate not found")
+)
+
+// observations is a structure used to model the Valet observations response.
+type observations struct {
+	Observations []observation `json:"observations"`
+}
+
+// observation holds the values of every series on a single date, keyed by series name (e.g. FXUSDCAD).
+// The date itself is stored under the "d" key.
+type observation map[string]json.RawMessage
+
+// seriesValue is the value of a single series in an observation.
+type seriesValue struct {
+	Value string `json:"v"`
+}
+
+// rateTable converts the most recent observation into a table of rates against the Canadian Dollar.
+func (o *observations) rateTable() (money.RateTable, error) {
+	if len(o.Observations) == 0 {
+		return money.RateTable{}, errMissingObservations
+	}
+
+	latest := o.Observations[len(o.Observations)-1]
+	rates := make(map[string]money.ExchangeRate, len(latest))
+
+	for series, raw := range latest {
+		code, err := seriesCurrency(series)
+		if err != nil {
+			continue
+		}
+
+		var value seriesValue
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return money.RateTable{}, fmt.Errorf("series %s: %w", series, err)
+		}
+
+		// the series are Canadian Dollars per unit of the foreign currency,
+		// the table expects units of the foreign currency per Canadian Dollar.
+		cadPerUnit, err := strconv.ParseFloat(value.Value, 64)
+		if err != nil || cadPerUnit <= 0 {
+			return money.RateTable{}, fmt.Errorf("series %s has invalid value %q", series, value.Value)
+		}
+
+		rates[code] = money.ExchangeRate(1 / cadPerUnit)
+	}
+
+	return money.RateTable{Base: baseCurrencyCode, Rates: rates}, nil
+}
+
+// seriesCurrency extracts the foreign currency code from a series name such as FXUSDCAD.
+func seriesCurrency(series string) (string, error) {
+	const seriesLength = len("FXUSDCAD")
+
+	if len(series) != seriesLength || !strings.HasPrefix(series, "FX") || !strings.HasSuffix(series, baseCurrencyCode) {
+		return "", errSeriesNotExchangeRate
+	}
+
+	return series[2:5], nil
+}
+
+// readRateTableFromResponse parses the response body into a table of rates against the Canadian Dollar.
+func readRateTableFromResponse(respBody io.Reader) (money.RateTable, error) {
+	decoder := json.NewDecoder(respBody)
+
+	var message observations
+	err := decoder.Decode(&message)
+	if err != nil {
+		return money.RateTable{}, fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+	}
+
+	table, err := message.rateTable()
+	if err != nil {
+		return money.RateTable{}, fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+	}
+
+	return table, nil
+}
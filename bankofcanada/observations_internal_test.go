@@ -0,0 +1,109 @@
+package bankofcanada
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSeriesCurrency(t *testing.T) {
+	type testCase struct {
+		series  string
+		want    string
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"exchange rate series": {
+			series: "FXUSDCAD",
+			want:   "USD",
+		},
+		"date key": {
+			series:  "d",
+			wantErr: errSeriesNotExchangeRate,
+		},
+		"not quoted in CAD": {
+			series:  "FXUSDEUR",
+			wantErr: errSeriesNotExchangeRate,
+		},
+		"other series": {
+			series:  "V39079",
+			wantErr: errSeriesNotExchangeRate,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := seriesCurrency(tc.series)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got error: %v, want: %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("got: %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadRateTableFromResponse(t *testing.T) {
+	type testCase struct {
+		body      string
+		wantRates map[string]float64
+		wantErr   error
+	}
+
+	testCases := map[string]testCase{
+		"latest observation is used": {
+			body: `{"observations": [
+				{"d": "2024-06-19", "FXUSDCAD": {"v": "1.2500"}},
+				{"d": "2024-06-20", "FXUSDCAD": {"v": "1.2000"}, "FXJPYCAD": {"v": "0.008"}}
+			]}`,
+			wantRates: map[string]float64{
+				"USD": 1 / 1.2,
+				"JPY": 125,
+			},
+		},
+		"not json": {
+			body:    `<xml/>`,
+			wantErr: ErrUnexpectedFormat,
+		},
+		"no observations": {
+			body:    `{"observations": []}`,
+			wantErr: ErrUnexpectedFormat,
+		},
+		"invalid value": {
+			body:    `{"observations": [{"d": "2024-06-20", "FXUSDCAD": {"v": "n/a"}}]}`,
+			wantErr: ErrUnexpectedFormat,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := readRateTableFromResponse(strings.NewReader(tc.body))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error: %v, want: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Base != baseCurrencyCode {
+				t.Errorf("got base: %s, want: %s", got.Base, baseCurrencyCode)
+			}
+			if len(got.Rates) != len(tc.wantRates) {
+				t.Errorf("got rates: %v, want: %v", got.Rates, tc.wantRates)
+			}
+			for code, want := range tc.wantRates {
+				if !almostEqual(float64(got.Rates[code]), want) {
+					t.Errorf("got %s: %g, want: %g", code, got.Rates[code], want)
+				}
+			}
+		})
+	}
+}
+
+// almostEqual compares two floats to 9 decimal places
+func almostEqual(f1, f2 float64) bool {
+	const threshold float64 = 1e-9
+	return math.Abs(f1-f2) < threshold
+}
@@ -1,28 +1,22 @@
 package ecbank
 
 import (
-	"fmt"
-	"net/http"
+	"io"
 
 	"github.com/th3oth3rjak3/MoneyConverter/money"
+	"github.com/th3oth3rjak3/MoneyConverter/ratefeed"
 )
 
+// The errors of a call to the bank are those of the ratefeed package.
 const (
 	// ErrCallingServer returned when an error occurs while calling the server to retrieve exchange rates.
-	ErrCallingServer = ecbankError("error calling server")
+	ErrCallingServer = ratefeed.ErrCallingServer
 	// ErrClientSide returned when a malformed client-side requests results in a 400 series error.
-	ErrClientSide = ecbankError("client-side error occurred")
+	ErrClientSide = ratefeed.ErrClientSide
 	// ErrServerSide returned when a server malfunction occurs and returns a 500 series error.
-	ErrServerSide = ecbankError("server-side error occurred")
+	ErrServerSide = ratefeed.ErrServerSide
 	// ErrUnknownStatusCode returned when any other status code is returned.
-	ErrUnknownStatusCode = ecbankError("unknown status code")
-)
-
-const (
-	// errors like 400 or 404
-	clientErrorClass = 4
-	// errors like 500
-	serverErrorClass = 5
+	ErrUnknownStatusCode = ratefeed.ErrUnknownStatusCode
 )
 
 // EuropeanCentralBank represents a structure that can call the bank to get exchange rates.
@@ -30,47 +24,67 @@ type EuropeanCentralBank struct {
 	url string
 }
 
+// New creates a EuropeanCentralBank that downloads the daily reference rates from url.
+// An empty url uses the public feed.
+func New(url string) EuropeanCentralBank {
+	return EuropeanCentralBank{url: url}
+}
+
 // FetchExchangeRate gets the exchange rate for the source to target currency.
 func (ecb EuropeanCentralBank) FetchExchangeRate(source, target money.Currency) (money.ExchangeRate, error) {
-	const ecbExchangeRateUrl string = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+	var rate money.ExchangeRate
 
-	if ecb.url == "" {
-		ecb.url = ecbExchangeRateUrl
+	err := ecb.fetch(func(body io.Reader) error {
+		var err error
+		rate, err = readRateFromResponse(source, target, body)
+		return err
+	})
+	if err != nil {
+		return 0., err
 	}
 
-	resp, err := http.Get(ecb.url)
+	return rate, nil
+}
+
+// FetchQuote gets the exchange rate for the source to target currency together with its reference date.
+func (ecb EuropeanCentralBank) FetchQuote(source, target money.Currency) (money.RateQuote, error) {
+	var quote money.RateQuote
+
+	err := ecb.fetch(func(body io.Reader) error {
+		var err error
+		quote, err = readQuoteFromResponse(source, target, body)
+		return err
+	})
 	if err != nil {
-		return money.ExchangeRate(0), fmt.Errorf("%w: %s", ErrCallingServer, err.Error())
+		return money.RateQuote{}, err
 	}
-	defer resp.Body.Close()
 
-	if err = checkStatusCode(resp.StatusCode); err != nil {
-		return money.ExchangeRate(0), err
-	}
+	return quote, nil
+}
+
+// FetchRateTable gets every daily reference rate in a single call, quoted against the Euro.
+func (ecb EuropeanCentralBank) FetchRateTable() (money.RateTable, error) {
+	var table money.RateTable
 
-	rate, err := readRateFromResponse(source.ISOCode(), target.ISOCode(), resp.Body)
+	err := ecb.fetch(func(body io.Reader) error {
+		var err error
+		table, err = readRateTableFromResponse(body)
+		return err
+	})
 	if err != nil {
-		return 0., err
+		return money.RateTable{}, err
 	}
 
-	return rate, nil
+	return table, nil
 }
 
-// checkStatusCode evaluates an http status code and returns an error if not success.
-func checkStatusCode(statusCode int) error {
-	switch {
-	case statusCode == http.StatusOK:
-		return nil
-	case httpStatusClass(statusCode) == clientErrorClass:
-		return fmt.Errorf("%w, %d", ErrClientSide, statusCode)
-	case httpStatusClass(statusCode) == serverErrorClass:
-		return fmt.Errorf("%w, %d", ErrServerSide, statusCode)
-	default:
-		return fmt.Errorf("%w, %d", ErrUnknownStatusCode, statusCode)
+// fetch calls the bank and passes the response body of a successful call to read.
+func (ecb EuropeanCentralBank) fetch(read func(io.Reader) error) error {
+	const ecbExchangeRateUrl string = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+	if ecb.url == "" {
+		ecb.url = ecbExchangeRateUrl
 	}
-}
 
-// httpStatusClass returns the first digit of the status code.
-func httpStatusClass(statusCode int) int {
-	return statusCode / 100
+	return ratefeed.Get(ecb.url, read)
 }
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/th3oth3rjak3/MoneyConverter/money"
 )
@@ -88,3 +90,175 @@ func TestEuroCentralBank_FetchExchangeRate_ClientError(t *testing.T) {
 		t.Errorf("got: %s, want: %s", err.Error(), ErrClientSide.Error())
 	}
 }
+
+func TestEuroCentralBank_FetchRateTable(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(
+			w,
+			`<?xml version="1.0" encoding="UTF-8"?>
+			<gesmes:Envelope>
+				<Cube>
+					<Cube>
+						<Cube currency="USD" rate="1.0688" />
+						<Cube currency="CAD" rate="1.4632" />
+					</Cube>
+				</Cube>
+			</gesmes:Envelope>`)
+	}))
+
+	defer ts.Close()
+
+	ecb := EuropeanCentralBank{
+		url: ts.URL,
+	}
+
+	got, err := ecb.FetchRateTable()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	want := money.RateTable{
+		Base: "EUR",
+		Rates: map[string]money.ExchangeRate{
+			"USD": 1.0688,
+			"CAD": 1.4632,
+			"EUR": 1,
+		},
+		Source: "ECB",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %#v, want: %#v", got, want)
+	}
+}
+
+func TestEuroCentralBank_FetchRateTable_ServerError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	defer ts.Close()
+
+	ecb := EuropeanCentralBank{
+		url: ts.URL,
+	}
+
+	_, err := ecb.FetchRateTable()
+	if !errors.Is(err, ErrServerSide) {
+		t.Errorf("got: %v, want: %s", err, ErrServerSide.Error())
+	}
+}
+
+func TestEuroCentralBank_FetchQuote(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(
+			w,
+			`<?xml version="1.0" encoding="UTF-8"?>
+			<gesmes:Envelope>
+				<Cube>
+					<Cube time="2024-05-17">
+						<Cube currency="USD" rate="1.0688" />
+						<Cube currency="CAD" rate="1.4632" />
+					</Cube>
+				</Cube>
+			</gesmes:Envelope>`)
+	}))
+
+	defer ts.Close()
+
+	ecb := EuropeanCentralBank{
+		url: ts.URL,
+	}
+
+	usd, cad := mustParseCurrency(t, "USD"), mustParseCurrency(t, "CAD")
+
+	got, err := ecb.FetchQuote(usd, cad)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	want := money.RateQuote{
+		Rate:   money.ExchangeRate(1.4632 / 1.0688),
+		Source: "ECB",
+		Date:   time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC),
+		Path:   []money.Currency{usd, cad},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %#v, want: %#v", got, want)
+	}
+}
+
+func TestEuroCentralBank_FetchQuote_MissingTime(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(
+			w,
+			`<?xml version="1.0" encoding="UTF-8"?>
+			<gesmes:Envelope>
+				<Cube>
+					<Cube>
+						<Cube currency="USD" rate="1.0688" />
+					</Cube>
+				</Cube>
+			</gesmes:Envelope>`)
+	}))
+
+	defer ts.Close()
+
+	ecb := EuropeanCentralBank{
+		url: ts.URL,
+	}
+
+	_, err := ecb.FetchQuote(mustParseCurrency(t, "USD"), mustParseCurrency(t, "EUR"))
+	if !errors.Is(err, ErrUnexpectedFormat) {
+		t.Errorf("got: %v, want: %s", err, ErrUnexpectedFormat.Error())
+	}
+}
+
+func TestEuroCentralBank_FetchQuote_SeveralDays(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(
+			w,
+			`<?xml version="1.0" encoding="UTF-8"?>
+			<gesmes:Envelope>
+				<Cube>
+					<Cube time="2024-05-17">
+						<Cube currency="USD" rate="1.10" />
+					</Cube>
+					<Cube time="2024-05-16">
+						<Cube currency="USD" rate="1.00" />
+						<Cube currency="CAD" rate="1.50" />
+					</Cube>
+				</Cube>
+			</gesmes:Envelope>`)
+	}))
+
+	defer ts.Close()
+
+	ecb := EuropeanCentralBank{
+		url: ts.URL,
+	}
+
+	eur, usd := mustParseCurrency(t, "EUR"), mustParseCurrency(t, "USD")
+
+	got, err := ecb.FetchQuote(eur, usd)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	want := money.RateQuote{
+		Rate:   1.1,
+		Source: "ECB",
+		Date:   time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC),
+		Path:   []money.Currency{eur, usd},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %#v, want: %#v", got, want)
+	}
+
+	// rates of earlier days are not mixed into the latest day.
+	if _, err := ecb.FetchQuote(eur, mustParseCurrency(t, "CAD")); !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("got: %v, want: %s", err, ErrExchangeRateNotFound.Error())
+	}
+}
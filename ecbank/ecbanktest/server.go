@@ -0,0 +1,311 @@
+// Package ecbanktest provides a fake European Central Bank server for testing code that uses the ecbank package.
+package ecbanktest
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/th3oth3rjak3/MoneyConverter/ecbank"
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+// The paths of the feeds, which match those of the public bank.
+const (
+	// DailyPath serves the reference rates of the latest day as XML.
+	DailyPath = "/stats/eurofxref/eurofxref-daily.xml"
+	// NinetyDayPath serves the reference rates of the last 90 days as XML.
+	NinetyDayPath = "/stats/eurofxref/eurofxref-hist-90d.xml"
+	// HistoryPath serves every reference rate as XML.
+	HistoryPath = "/stats/eurofxref/eurofxref-hist.xml"
+	// DailyZipPath serves the reference rates of the latest day as a zipped CSV file.
+	DailyZipPath = "/stats/eurofxref/eurofxref.zip"
+	// HistoryZipPath serves every reference rate as a zipped CSV file.
+	HistoryZipPath = "/stats/eurofxref/eurofxref-hist.zip"
+)
+
+// Day is the reference rates of one day, quoted in units of the currency per Euro.
+type Day struct {
+	Date  time.Time
+	Rates map[string]money.ExchangeRate
+}
+
+// Server is a fake of the bank's rate feeds, serving a series of days held in memory.
+// Responses carry a Last-Modified header of the latest day and conditional requests are answered
+// with 304 Not Modified. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, of the form http://ipaddr:port with no trailing slash.
+	URL string
+
+	server *httptest.Server
+
+	mu        sync.Mutex
+	days      []Day
+	status    int
+	delay     time.Duration
+	malformed bool
+	requests  int
+}
+
+// NewServer starts a Server serving the days. The caller should call Close when finished.
+func NewServer(days ...Day) *Server {
+	s := &Server{}
+	s.SetDays(days...)
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server, blocking until all outstanding requests have completed.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a EuropeanCentralBank that downloads the daily feed of the server.
+func (s *Server) Client() ecbank.EuropeanCentralBank {
+	return ecbank.New(s.URL + DailyPath)
+}
+
+// SetDays replaces the series of days served. The days may be in any order.
+func (s *Server) SetDays(days ...Day) {
+	sorted := slices.Clone(days)
+	// the feeds list the latest day first.
+	slices.SortFunc(sorted, func(a, b Day) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.days = sorted
+}
+
+// AddDay adds a day to the series served, e.g. to publish the rates of a new day.
+func (s *Server) AddDay(day Day) {
+	s.mu.Lock()
+	days := append(slices.Clone(s.days), day)
+	s.mu.Unlock()
+
+	s.SetDays(days...)
+}
+
+// SetStatus makes every response fail with the status code, e.g. http.StatusServiceUnavailable,
+// or clears the failure when it is 0.
+func (s *Server) SetStatus(statusCode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.status = statusCode
+}
+
+// SetDelay delays every response by the duration, or until the request is cancelled.
+func (s *Server) SetDelay(delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delay = delay
+}
+
+// SetMalformed makes every successful response carry a body that cannot be decoded.
+func (s *Server) SetMalformed(malformed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.malformed = malformed
+}
+
+// Requests returns the number of requests received.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// serveHTTP answers a request for one of the feeds.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	days, status, delay, malformed := s.days, s.status, s.delay, s.malformed
+	s.mu.Unlock()
+
+	select {
+	case <-time.After(delay):
+	case <-r.Context().Done():
+		return
+	}
+
+	if status != 0 {
+		w.WriteHeader(status)
+		return
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+
+	switch r.URL.Path {
+	case DailyPath:
+		body, contentType = envelope(days[:min(len(days), 1)]), "text/xml"
+	case NinetyDayPath:
+		body, contentType = envelope(lastNinetyDays(days)), "text/xml"
+	case HistoryPath:
+		body, contentType = envelope(days), "text/xml"
+	case DailyZipPath:
+		body, contentType = archive("eurofxref.csv", dailyCSV(days)), "application/zip"
+	case HistoryZipPath:
+		body, contentType = archive("eurofxref-hist.csv", historyCSV(days)), "application/zip"
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	if malformed {
+		// a response cut short, which neither XML nor zip readers accept.
+		body = body[:len(body)/2]
+	}
+
+	var modified time.Time
+	if len(days) > 0 {
+		// the rates change once a day, so the latest day is the modification time of every feed.
+		modified = days[0].Date
+	}
+
+	// the content type is set so that ServeContent does not sniff it.
+	w.Header().Set("Content-Type", contentType)
+	http.ServeContent(w, r, "", modified, bytes.NewReader(body))
+}
+
+// lastNinetyDays returns the days within 90 days of the latest one.
+func lastNinetyDays(days []Day) []Day {
+	if len(days) == 0 {
+		return nil
+	}
+
+	oldest := days[0].Date.AddDate(0, 0, -90)
+	end := len(days)
+	for i, day := range days {
+		if day.Date.Before(oldest) {
+			end = i
+			break
+		}
+	}
+
+	return days[:end]
+}
+
+// envelope writes the days in the XML layout of the bank's feeds.
+func envelope(days []Day) []byte {
+	var b strings.Builder
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	b.WriteString(`<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">` + "\n")
+	b.WriteString("\t<gesmes:subject>Reference rates</gesmes:subject>\n")
+	b.WriteString("\t<gesmes:Sender>\n\t\t<gesmes:name>European Central Bank</gesmes:name>\n\t</gesmes:Sender>\n")
+	b.WriteString("\t<Cube>\n")
+
+	for _, day := range days {
+		fmt.Fprintf(&b, "\t\t<Cube time='%s'>\n", day.Date.Format(time.DateOnly))
+		for _, code := range currencyCodes([]Day{day}) {
+			fmt.Fprintf(&b, "\t\t\t<Cube currency='%s' rate='%s'/>\n", code, formatRate(day.Rates[code]))
+		}
+		b.WriteString("\t\t</Cube>\n")
+	}
+
+	b.WriteString("\t</Cube>\n</gesmes:Envelope>\n")
+	return []byte(b.String())
+}
+
+// dailyCSV writes the latest day in the CSV layout of the bank's daily zip file, e.g.
+//
+//	Date, USD, JPY,
+//	17 May 2024, 1.0866, 169.51,
+func dailyCSV(days []Day) string {
+	var header, values strings.Builder
+	header.WriteString("Date, ")
+
+	if len(days) > 0 {
+		values.WriteString(days[0].Date.Format("02 January 2006") + ", ")
+		for _, code := range currencyCodes(days[:1]) {
+			header.WriteString(code + ", ")
+			values.WriteString(formatRate(days[0].Rates[code]) + ", ")
+		}
+	}
+
+	return header.String() + "\n" + values.String() + "\n"
+}
+
+// historyCSV writes the days in the CSV layout of the bank's history zip file, where currencies
+// without a rate on a day are N/A, e.g.
+//
+//	Date,USD,JPY,
+//	2024-05-17,1.0866,169.51,
+func historyCSV(days []Day) string {
+	codes := currencyCodes(days)
+
+	var b strings.Builder
+	b.WriteString("Date," + strings.Join(codes, ",") + ",\n")
+
+	for _, day := range days {
+		b.WriteString(day.Date.Format(time.DateOnly) + ",")
+		for _, code := range codes {
+			if rate, found := day.Rates[code]; found {
+				b.WriteString(formatRate(rate) + ",")
+			} else {
+				b.WriteString("N/A,")
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// archive returns a zip file holding a single file with the name and content.
+func archive(name, content string) []byte {
+	var buf bytes.Buffer
+
+	writer := zip.NewWriter(&buf)
+	file, err := writer.Create(name)
+	if err == nil {
+		_, err = file.Write([]byte(content))
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		// writing to memory cannot fail.
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
+
+// currencyCodes returns the codes of every currency quoted on any of the days, in order.
+func currencyCodes(days []Day) []string {
+	var codes []string
+	for _, day := range days {
+		for code := range day.Rates {
+			if !slices.Contains(codes, code) {
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	slices.Sort(codes)
+	return codes
+}
+
+// formatRate writes the rate without an exponent, like the bank does.
+func formatRate(rate money.ExchangeRate) string {
+	return strconv.FormatFloat(float64(rate), 'f', -1, 64)
+}
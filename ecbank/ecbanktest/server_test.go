@@ -0,0 +1,292 @@
+package ecbanktest_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/th3oth3rjak3/MoneyConverter/ecbank"
+	"github.com/th3oth3rjak3/MoneyConverter/ecbank/ecbanktest"
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+	"github.com/th3oth3rjak3/MoneyConverter/money/moneytest"
+)
+
+var (
+	latest = ecbanktest.Day{
+		Date:  time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC),
+		Rates: map[string]money.ExchangeRate{"USD": 1.0866, "JPY": 169.51},
+	}
+	previous = ecbanktest.Day{
+		Date:  time.Date(2024, time.May, 16, 0, 0, 0, 0, time.UTC),
+		Rates: map[string]money.ExchangeRate{"USD": 1.0858, "JPY": 168.53, "CAD": 1.4781},
+	}
+	old = ecbanktest.Day{
+		Date:  time.Date(2023, time.May, 17, 0, 0, 0, 0, time.UTC),
+		Rates: map[string]money.ExchangeRate{"USD": 1.0844},
+	}
+)
+
+// get downloads the feed at the path of the server.
+func get(t *testing.T, server *ecbanktest.Server, path string) (*http.Response, []byte) {
+	t.Helper()
+
+	resp, err := http.Get(server.URL + path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	return resp, body
+}
+
+func TestServer_Client(t *testing.T) {
+	server := ecbanktest.NewServer(previous, latest)
+	defer server.Close()
+
+	usd, jpy := moneytest.MustCurrency(t, "USD"), moneytest.MustCurrency(t, "JPY")
+
+	got, err := server.Client().FetchQuote(usd, jpy)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	want := money.RateQuote{
+		// divided at run time like the client, as constant division is exact.
+		Rate:   latest.Rates["JPY"] / latest.Rates["USD"],
+		Source: "ECB",
+		Date:   latest.Date,
+		Path:   []money.Currency{usd, jpy},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %#v, want: %#v", got, want)
+	}
+
+	if requests := server.Requests(); requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
+
+func TestServer_Client_ConvertDetailedThroughTriangulator(t *testing.T) {
+	server := ecbanktest.NewServer(previous, latest)
+	defer server.Close()
+
+	jpy := moneytest.MustCurrency(t, "JPY")
+	triangulator := money.NewTriangulator([]money.Currency{moneytest.MustCurrency(t, "EUR")}, server.Client())
+
+	got, err := money.ConvertDetailed(moneytest.MustAmount(t, "100", "USD"), jpy, triangulator, money.RoundHalfEven)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got.Source != "ECB" || !got.RateDate.Equal(latest.Date) {
+		t.Errorf("got source %q and date %s, want ECB and %s", got.Source, got.RateDate, latest.Date)
+	}
+
+	if requests := server.Requests(); requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
+
+func TestServer_Client_Failures(t *testing.T) {
+	type testCase struct {
+		configure func(server *ecbanktest.Server)
+		wantErr   error
+	}
+
+	testCases := map[string]testCase{
+		"server error": {
+			configure: func(server *ecbanktest.Server) { server.SetStatus(http.StatusServiceUnavailable) },
+			wantErr:   ecbank.ErrServerSide,
+		},
+		"not modified": {
+			configure: func(server *ecbanktest.Server) { server.SetStatus(http.StatusNotModified) },
+			wantErr:   ecbank.ErrUnknownStatusCode,
+		},
+		"malformed body": {
+			configure: func(server *ecbanktest.Server) { server.SetMalformed(true) },
+			wantErr:   ecbank.ErrUnexpectedFormat,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := ecbanktest.NewServer(latest)
+			defer server.Close()
+
+			tc.configure(server)
+
+			_, err := server.Client().FetchRateTable()
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestServer_Feeds(t *testing.T) {
+	type testCase struct {
+		path   string
+		want   []string
+		absent []string
+	}
+
+	testCases := map[string]testCase{
+		"daily": {
+			path:   ecbanktest.DailyPath,
+			want:   []string{"<Cube time='2024-05-17'>", "<Cube currency='USD' rate='1.0866'/>"},
+			absent: []string{"2024-05-16", "2023-05-17"},
+		},
+		"90 days": {
+			path:   ecbanktest.NinetyDayPath,
+			want:   []string{"<Cube time='2024-05-17'>", "<Cube time='2024-05-16'>", "<Cube currency='CAD' rate='1.4781'/>"},
+			absent: []string{"2023-05-17"},
+		},
+		"history": {
+			path: ecbanktest.HistoryPath,
+			want: []string{"<Cube time='2024-05-17'>", "<Cube time='2024-05-16'>", "<Cube time='2023-05-17'>"},
+		},
+	}
+
+	server := ecbanktest.NewServer(old, latest, previous)
+	defer server.Close()
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp, body := get(t, server, tc.path)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("got status %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			for _, want := range tc.want {
+				if !bytes.Contains(body, []byte(want)) {
+					t.Errorf("body does not contain %q:\n%s", want, body)
+				}
+			}
+
+			for _, unwanted := range tc.absent {
+				if bytes.Contains(body, []byte(unwanted)) {
+					t.Errorf("body contains %q:\n%s", unwanted, body)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_ZipFeeds(t *testing.T) {
+	type testCase struct {
+		path string
+		name string
+		want string
+	}
+
+	testCases := map[string]testCase{
+		"daily": {
+			path: ecbanktest.DailyZipPath,
+			name: "eurofxref.csv",
+			want: "Date, JPY, USD, \n17 May 2024, 169.51, 1.0866, \n",
+		},
+		"history": {
+			path: ecbanktest.HistoryZipPath,
+			name: "eurofxref-hist.csv",
+			want: "Date,CAD,JPY,USD,\n2024-05-17,N/A,169.51,1.0866,\n2024-05-16,1.4781,168.53,1.0858,\n",
+		},
+	}
+
+	server := ecbanktest.NewServer(latest, previous)
+	defer server.Close()
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, body := get(t, server, tc.path)
+
+			archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(archive.File) != 1 || archive.File[0].Name != tc.name {
+				t.Fatalf("got files %v, want only %s", archive.File, tc.name)
+			}
+
+			file, err := archive.File[0].Open()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			defer file.Close()
+
+			var got strings.Builder
+			if _, err := io.Copy(&got, file); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got: %q, want: %q", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestServer_NotModified(t *testing.T) {
+	server := ecbanktest.NewServer(previous)
+	defer server.Close()
+
+	resp, _ := get(t, server, ecbanktest.DailyPath)
+	lastModified := resp.Header.Get("Last-Modified")
+
+	request := func() int {
+		req, err := http.NewRequest(http.MethodGet, server.URL+ecbanktest.DailyPath, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		req.Header.Set("If-Modified-Since", lastModified)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	if status := request(); status != http.StatusNotModified {
+		t.Errorf("got status %d, want %d", status, http.StatusNotModified)
+	}
+
+	server.AddDay(latest)
+
+	if status := request(); status != http.StatusOK {
+		t.Errorf("got status %d after a new day, want %d", status, http.StatusOK)
+	}
+}
+
+func TestServer_Delay(t *testing.T) {
+	server := ecbanktest.NewServer(latest)
+	defer server.Close()
+
+	server.SetDelay(time.Second)
+
+	client := http.Client{Timeout: 20 * time.Millisecond}
+	if _, err := client.Get(server.URL + ecbanktest.DailyPath); err == nil {
+		t.Errorf("got no error, want a timeout")
+	}
+
+	server.SetDelay(0)
+
+	resp, _ := get(t, server, ecbanktest.DailyPath)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
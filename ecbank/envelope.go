@@ -1,85 +1,101 @@
 package ecbank
 
 import (
-	"encoding/xml"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/th3oth3rjak3/MoneyConverter/money"
+	"github.com/th3oth3rjak3/MoneyConverter/ratefeed"
 )
 
 // the ecbank api compares all values to the Euro.
 const baseCurrencyCode = "EUR"
 
+// quoteSource names the bank as the source of its quotes.
+const quoteSource = "ECB"
+
 const (
-	errEnvelopeMissingSource = ecbankError("envelope missing source")
-	errEnvelopeMissingTarget = ecbankError("envelope missing target")
-	ErrUnexpectedFormat      = ecbankError("response body was not in the expected format")
-	ErrExchangeRateNotFound  = ecbankError("exchange rate not found")
+	ErrUnexpectedFormat     = ecbankError("response body was not in the expected format")
+	ErrExchangeRateNotFound = ecbankError("exchange rate not found")
 )
 
-// envelope is a structure used to model the current bank api.
-type envelope struct {
-	Rates []currencyRate `xml:"Cube>Cube>Cube"`
-}
-
-// currencyRate contains the currency code and exchange rate.
-type currencyRate struct {
-	Currency string             `xml:"currency,attr"`
-	Rate     money.ExchangeRate `xml:"rate,attr"`
-}
+// readRateTableFromResponse parses the response body into a table of rates against the Euro,
+// naming the bank as their source and dating them when the body gives their reference date.
+func readRateTableFromResponse(respBody io.Reader) (money.RateTable, error) {
+	table, day, err := decodeEnvelope(respBody)
+	if err != nil {
+		return money.RateTable{}, err
+	}
 
-// exchangeRates converts the envelope exchanges rates into a map by currency name.
-func (e *envelope) exchangeRates() map[string]money.ExchangeRate {
-	rates := make(map[string]money.ExchangeRate, len(e.Rates)+1)
+	table.Source = quoteSource
 
-	for _, c := range e.Rates {
-		rates[c.Currency] = c.Rate
+	if day != "" {
+		table.Date, err = time.Parse(time.DateOnly, day)
+		if err != nil {
+			return money.RateTable{}, fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+		}
 	}
 
-	rates[baseCurrencyCode] = 1.
-
-	return rates
+	return table, nil
 }
 
-// exchangeRate calculates the exchange rate from the source to target currency
-func (e *envelope) exchangeRate(source, target string) (money.ExchangeRate, error) {
-	if source == target {
-		return 1., nil
+// readQuoteFromResponse parses the response body and gets the exchange rate from the source to the target
+// together with its reference date.
+func readQuoteFromResponse(source, target money.Currency, respBody io.Reader) (money.RateQuote, error) {
+	table, day, err := decodeEnvelope(respBody)
+	if err != nil {
+		return money.RateQuote{}, err
 	}
 
-	rates := e.exchangeRates()
-
-	sourceFactor, sourceFound := rates[source]
-	if !sourceFound {
-		return 0, errEnvelopeMissingSource
+	rate, err := exchangeRate(table, source, target)
+	if err != nil {
+		return money.RateQuote{}, err
 	}
 
-	targetFactor, targetFound := rates[target]
-	if !targetFound {
-		return 0, errEnvelopeMissingTarget
+	date, err := time.Parse(time.DateOnly, day)
+	if err != nil {
+		return money.RateQuote{}, fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
 	}
 
-	// note: 1 / (EUR -> USD) == USD -> EUR
-	// scenario: if going from USD -> CAD
-	// 1 / (EUR -> USD) * EUR -> CAD == (EUR -> CAD) / (EUR -> USD) == target / source
-	return targetFactor / sourceFactor, nil
+	return money.RateQuote{
+		Rate:   rate,
+		Source: quoteSource,
+		Date:   date,
+		Path:   []money.Currency{source, target},
+	}, nil
 }
 
 // readRateFromResponse parses the response body and gets the exchange from the source to the target.
-func readRateFromResponse(source, target string, respBody io.Reader) (money.ExchangeRate, error) {
-	decoder := xml.NewDecoder(respBody)
-
-	var ecbMessage envelope
-	err := decoder.Decode(&ecbMessage)
+func readRateFromResponse(source, target money.Currency, respBody io.Reader) (money.ExchangeRate, error) {
+	table, _, err := decodeEnvelope(respBody)
 	if err != nil {
-		return 0., fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+		return 0., err
 	}
 
-	rate, err := ecbMessage.exchangeRate(source, target)
+	return exchangeRate(table, source, target)
+}
+
+// exchangeRate calculates the exchange rate from the source to target currency.
+func exchangeRate(table money.RateTable, source, target money.Currency) (money.ExchangeRate, error) {
+	rate, err := table.FetchExchangeRate(source, target)
 	if err != nil {
 		return 0., fmt.Errorf("%w: %s", ErrExchangeRateNotFound, err)
 	}
 
 	return rate, nil
 }
+
+// decodeEnvelope parses the response body into a table of rates against the Euro and their reference date,
+// e.g. "2024-05-17". The 90-day and history feeds decode to their latest day.
+func decodeEnvelope(respBody io.Reader) (money.RateTable, string, error) {
+	table, date, err := ratefeed.ECB.DecodeWithDate(respBody)
+	if err != nil {
+		return money.RateTable{}, "", fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+	}
+
+	// the Euro is quoted against itself, so that the table lists every currency of the feed.
+	table.Rates[baseCurrencyCode] = 1.
+
+	return table, date, nil
+}
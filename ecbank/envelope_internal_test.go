@@ -4,132 +4,110 @@ import (
 	"errors"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/th3oth3rjak3/MoneyConverter/money"
 )
 
-func TestEnvelopeExchangeRates(t *testing.T) {
+func TestDecodeEnvelope(t *testing.T) {
 	type testCase struct {
-		envelope *envelope
+		body     string
 		want     map[string]money.ExchangeRate
+		wantDate string
+		wantErr  error
 	}
 
 	testCases := map[string]testCase{
-		"empty list": {
-			envelope: &envelope{
-				Rates: []currencyRate{},
-			},
+		"some values": {
+			body: `<gesmes:Envelope><Cube><Cube time="2024-05-17">` +
+				`<Cube currency="USD" rate="1.1" /><Cube currency="CAD" rate="1.3" />` +
+				`</Cube></Cube></gesmes:Envelope>`,
 			want: map[string]money.ExchangeRate{
-				"EUR": 1.,
+				"USD": 1.1,
+				"CAD": 1.3,
+				"EUR": 1.0,
 			},
+			wantDate: "2024-05-17",
 		},
-		"some values": {
-			envelope: &envelope{
-				Rates: []currencyRate{
-					{
-						Currency: "USD",
-						Rate:     1.1,
-					},
-					{
-						Currency: "CAD",
-						Rate:     1.3,
-					},
-				},
-			},
+		"several days": {
+			body: `<gesmes:Envelope><Cube>` +
+				`<Cube time="2024-05-17"><Cube currency="USD" rate="1.1" /></Cube>` +
+				`<Cube time="2024-05-16"><Cube currency="USD" rate="1.0" /><Cube currency="CAD" rate="1.3" /></Cube>` +
+				`</Cube></gesmes:Envelope>`,
 			want: map[string]money.ExchangeRate{
 				"USD": 1.1,
-				"CAD": 1.3,
 				"EUR": 1.0,
 			},
+			wantDate: "2024-05-17",
+		},
+		"no rates": {
+			body:    `<gesmes:Envelope><Cube><Cube time="2024-05-17"></Cube></Cube></gesmes:Envelope>`,
+			wantErr: ErrUnexpectedFormat,
+		},
+		"malformed": {
+			body:    `<gesmes:Envelope><Cube>`,
+			wantErr: ErrUnexpectedFormat,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			got := tc.envelope.exchangeRates()
-			if !reflect.DeepEqual(got, tc.want) {
-				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			got, date, err := decodeEnvelope(strings.NewReader(tc.body))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error: %v, wanted error: %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got.Rates, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got.Rates, tc.want)
+			}
+			if date != tc.wantDate {
+				t.Errorf("got date: %q, want: %q", date, tc.wantDate)
 			}
 		})
 	}
 }
 
-func TestEnvelopeExchangeRate(t *testing.T) {
+func TestExchangeRate(t *testing.T) {
 	type testCase struct {
-		from     string
-		to       string
-		envelope *envelope
-		want     money.ExchangeRate
-		wantErr  error
+		from    string
+		to      string
+		rates   map[string]money.ExchangeRate
+		want    money.ExchangeRate
+		wantErr error
 	}
 
 	testCases := map[string]testCase{
 		"to same currency": {
-			from: "USD",
-			to:   "USD",
-			envelope: &envelope{
-				Rates: []currencyRate{
-					{
-						Currency: "USD",
-						Rate:     1.2,
-					},
-				},
-			},
-			want:    1.0,
-			wantErr: nil,
+			from:  "USD",
+			to:    "USD",
+			rates: map[string]money.ExchangeRate{"USD": 1.2},
+			want:  1.0,
 		},
 		"USD to CAD": {
-			from: "USD",
-			to:   "CAD",
-			envelope: &envelope{
-				Rates: []currencyRate{
-					{
-						Currency: "USD",
-						Rate:     1.4,
-					},
-					{
-						Currency: "CAD",
-						Rate:     1.2,
-					},
-				},
-			},
-			want:    0.857142857,
-			wantErr: nil,
+			from:  "USD",
+			to:    "CAD",
+			rates: map[string]money.ExchangeRate{"USD": 1.4, "CAD": 1.2},
+			want:  0.857142857,
 		},
 		"missing source": {
-			from: "USD",
-			to:   "CAD",
-			envelope: &envelope{
-				Rates: []currencyRate{
-					{
-						Currency: "CAD",
-						Rate:     1.2,
-					},
-				},
-			},
-			want:    0,
-			wantErr: errEnvelopeMissingSource,
+			from:    "USD",
+			to:      "CAD",
+			rates:   map[string]money.ExchangeRate{"CAD": 1.2},
+			wantErr: ErrExchangeRateNotFound,
 		},
 		"missing target": {
-			from: "USD",
-			to:   "CAD",
-			envelope: &envelope{
-				Rates: []currencyRate{
-					{
-						Currency: "USD",
-						Rate:     1.4,
-					},
-				},
-			},
-			want:    0,
-			wantErr: errEnvelopeMissingTarget,
+			from:    "USD",
+			to:      "CAD",
+			rates:   map[string]money.ExchangeRate{"USD": 1.4},
+			wantErr: ErrExchangeRateNotFound,
 		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			got, err := tc.envelope.exchangeRate(tc.from, tc.to)
+			table := money.RateTable{Base: baseCurrencyCode, Rates: tc.rates}
+
+			got, err := exchangeRate(table, mustParseCurrency(t, tc.from), mustParseCurrency(t, tc.to))
 			if !errors.Is(err, tc.wantErr) {
 				t.Errorf("got error: %s, wanted error: %s", err, tc.wantErr)
 			}
@@ -47,12 +47,12 @@ func main() {
 		os.Exit(1)
 	}
 
-	bank := ecbank.EuropeanCentralBank{}
+	bank := ecbank.New("")
 	convertedAmount, err := money.Convert(fromAmount, targetCurrency, bank)
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "Failed to convert currency: %s", err.Error())
 		os.Exit(1)
 	}
 
-	fmt.Printf("%s = %s\n", &fromAmount, &convertedAmount)
+	fmt.Printf("%s = %s\n", fromAmount, convertedAmount)
 }
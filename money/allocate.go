@@ -0,0 +1,123 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+)
+
+const (
+	// ErrInvalidSplit is returned when an Amount is split into fewer than one part.
+	ErrInvalidSplit = Error("amount must be split into at least one part")
+	// ErrInvalidRatios is returned when allocation ratios are negative or do not have a positive sum.
+	ErrInvalidRatios = Error("ratios must not be negative and must have a positive sum")
+)
+
+// TieBreak decides which parts receive the remaining minor units when their remainders are equal.
+type TieBreak uint8
+
+const (
+	// TieBreakFirst favours parts earlier in the list.
+	TieBreakFirst TieBreak = iota
+	// TieBreakLast favours parts later in the list.
+	TieBreakLast
+	// TieBreakLargestRatio favours parts with the largest ratio, then parts earlier in the list.
+	TieBreakLargestRatio
+)
+
+// Split divides the Amount into n parts that differ by at most one minor unit and sum exactly to the Amount.
+// Earlier parts receive the remaining minor units, e.g. 100.00 EUR split 3 ways is 33.34, 33.33 and 33.33 EUR.
+func (a Amount) Split(n int) ([]Amount, error) {
+	if n < 1 {
+		return nil, ErrInvalidSplit
+	}
+
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+
+	return a.Allocate(ratios...)
+}
+
+// Allocate divides the Amount in proportion to the ratios, favouring earlier parts on ties.
+// See AllocateTieBreak.
+func (a Amount) Allocate(ratios ...int) ([]Amount, error) {
+	return a.AllocateTieBreak(TieBreakFirst, ratios...)
+}
+
+// AllocateTieBreak divides the Amount in proportion to the ratios, using the largest remainder method
+// so that the parts sum exactly to the Amount. Each part first receives its share rounded towards zero,
+// the remaining minor units then go one each to the parts with the largest remainders,
+// with equal remainders ordered by the tie break.
+// Example: 100.00 EUR allocated 70/20/10 is 70.00, 20.00 and 10.00 EUR.
+func (a Amount) AllocateTieBreak(tieBreak TieBreak, ratios ...int) ([]Amount, error) {
+	total := new(big.Int)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidRatios
+		}
+		total.Add(total, big.NewInt(int64(ratio)))
+	}
+
+	if total.Sign() == 0 {
+		return nil, ErrInvalidRatios
+	}
+
+	// allocate the magnitude so that negative amounts are rounded symmetrically.
+	magnitude := new(big.Int).Abs(big.NewInt(a.quantity.units))
+	leftover := new(big.Int).Set(magnitude)
+
+	shares := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	for i, ratio := range ratios {
+		product := new(big.Int).Mul(magnitude, big.NewInt(int64(ratio)))
+		shares[i], remainders[i] = product.QuoRem(product, total, new(big.Int))
+		leftover.Sub(leftover, shares[i])
+	}
+
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(x, y int) bool {
+		i, j := order[x], order[y]
+		if c := remainders[i].Cmp(remainders[j]); c != 0 {
+			return c > 0
+		}
+
+		switch tieBreak {
+		case TieBreakLast:
+			return i > j
+		case TieBreakLargestRatio:
+			if ratios[i] != ratios[j] {
+				return ratios[i] > ratios[j]
+			}
+			return i < j
+		default:
+			return i < j
+		}
+	})
+
+	// the leftover is less than the number of parts, so each part receives at most one unit.
+	one := big.NewInt(1)
+	for _, i := range order[:leftover.Int64()] {
+		shares[i].Add(shares[i], one)
+	}
+
+	parts := make([]Amount, len(ratios))
+	for i, share := range shares {
+		// every share is at most the magnitude, which fits in an int64 once the sign is restored.
+		units := share.Int64()
+		if a.quantity.units < 0 {
+			units = -units
+		}
+
+		parts[i] = Amount{
+			quantity: Decimal{units: units, precision: a.quantity.precision},
+			currency: a.currency,
+		}
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,192 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// centsOf returns the units of each Amount.
+func centsOf(amounts []Amount) []int64 {
+	cents := make([]int64, len(amounts))
+	for i, amount := range amounts {
+		cents[i] = amount.quantity.units
+	}
+
+	return cents
+}
+
+func TestAmountSplit(t *testing.T) {
+	type testCase struct {
+		in      Amount
+		parts   int
+		want    []int64
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"three ways": {
+			in:    eur(10000),
+			parts: 3,
+			want:  []int64{3334, 3333, 3333},
+		},
+		"even split": {
+			in:    eur(10000),
+			parts: 4,
+			want:  []int64{2500, 2500, 2500, 2500},
+		},
+		"negative": {
+			in:    eur(-10000),
+			parts: 3,
+			want:  []int64{-3334, -3333, -3333},
+		},
+		"more parts than units": {
+			in:    eur(2),
+			parts: 3,
+			want:  []int64{1, 1, 0},
+		},
+		"single part": {
+			in:    eur(1),
+			parts: 1,
+			want:  []int64{1},
+		},
+		"no parts": {
+			in:      eur(1),
+			parts:   0,
+			wantErr: ErrInvalidSplit,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.Split(tc.parts)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if cents := centsOf(got); !reflect.DeepEqual(cents, tc.want) {
+				t.Errorf("got: %v, want: %v", cents, tc.want)
+			}
+			for _, part := range got {
+				if part.currency != tc.in.currency {
+					t.Errorf("got currency: %#v, want: %#v", part.currency, tc.in.currency)
+				}
+			}
+		})
+	}
+}
+
+func TestAmountAllocateTieBreak(t *testing.T) {
+	type testCase struct {
+		in       Amount
+		tieBreak TieBreak
+		ratios   []int
+		want     []int64
+		wantErr  error
+	}
+
+	testCases := map[string]testCase{
+		"70/20/10": {
+			in:     eur(10000),
+			ratios: []int{70, 20, 10},
+			want:   []int64{7000, 2000, 1000},
+		},
+		"largest remainder wins": {
+			in:     eur(5),
+			ratios: []int{1, 2, 1},
+			// exact shares are 1.25, 2.5 and 1.25 cents.
+			want: []int64{1, 3, 1},
+		},
+		"tie break first": {
+			in:       eur(5),
+			tieBreak: TieBreakFirst,
+			ratios:   []int{1, 1, 1, 1},
+			want:     []int64{2, 1, 1, 1},
+		},
+		"tie break last": {
+			in:       eur(5),
+			tieBreak: TieBreakLast,
+			ratios:   []int{1, 1, 1, 1},
+			want:     []int64{1, 1, 1, 2},
+		},
+		"tie break largest ratio": {
+			in:       eur(2),
+			tieBreak: TieBreakLargestRatio,
+			ratios:   []int{1, 3},
+			// exact shares are 0.5 and 1.5 cents.
+			want: []int64{0, 2},
+		},
+		"tie break largest ratio then first": {
+			in:       eur(7),
+			tieBreak: TieBreakLargestRatio,
+			ratios:   []int{1, 2, 1, 2},
+			want:     []int64{1, 3, 1, 2},
+		},
+		"zero ratio receives nothing": {
+			in:     eur(101),
+			ratios: []int{1, 0, 1},
+			want:   []int64{51, 0, 50},
+		},
+		"largest amount": {
+			in:     eur(math.MaxInt64),
+			ratios: []int{math.MaxInt, math.MaxInt},
+			want:   []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2},
+		},
+		"smallest amount": {
+			in:     eur(math.MinInt64),
+			ratios: []int{1},
+			want:   []int64{math.MinInt64},
+		},
+		"negative ratio": {
+			in:      eur(100),
+			ratios:  []int{1, -1},
+			wantErr: ErrInvalidRatios,
+		},
+		"zero ratios": {
+			in:      eur(100),
+			ratios:  []int{0, 0},
+			wantErr: ErrInvalidRatios,
+		},
+		"no ratios": {
+			in:      eur(100),
+			wantErr: ErrInvalidRatios,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.AllocateTieBreak(tc.tieBreak, tc.ratios...)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if cents := centsOf(got); err == nil && !reflect.DeepEqual(cents, tc.want) {
+				t.Errorf("got: %v, want: %v", cents, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountAllocateSumsToOriginal(t *testing.T) {
+	ratioSets := [][]int{{1}, {1, 1, 1}, {70, 20, 10}, {3, 7, 11, 13}, {1, 0, 2, 0, 3}, {999, 1}}
+
+	for _, units := range []int64{0, 1, 7, 100, 10000, 99999, -12345} {
+		for _, ratios := range ratioSets {
+			parts, err := eur(units).Allocate(ratios...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var sum int64
+			for _, part := range parts {
+				sum += part.quantity.units
+			}
+
+			if sum != units {
+				t.Errorf("allocating %d by %v summed to %d", units, ratios, sum)
+			}
+		}
+	}
+}
@@ -1,10 +1,16 @@
 package money
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
 
 const (
 	// ErrTooPrecise is returned when the precision of the input is greater than that of the currency.
 	ErrTooPrecise = Error("quantity is too precise")
+	// ErrCurrencyMismatch is returned when an operation combines Amounts in different currencies.
+	ErrCurrencyMismatch = Error("currencies do not match")
 )
 
 // Amount represents a quantity of money in a specific currency.
@@ -20,14 +26,142 @@ func NewAmount(quantity Decimal, currency Currency) (Amount, error) {
 		return Amount{}, ErrTooPrecise
 	}
 
-	// Should not have an error since we're checking for precision issues
-	// in the upper guard clause.
-	_ = quantity.updatePrecision(currency.precision)
+	// the precision only increases, but the units may no longer fit.
+	if err := quantity.updatePrecision(currency.precision); err != nil {
+		return Amount{}, err
+	}
 
 	return Amount{quantity: quantity, currency: currency}, nil
 }
 
+// AmountFromMinorUnits creates a new Amount from a count of the currency's minor units, e.g. cents.
+// Example: AmountFromMinorUnits(1234, USD) is 12.34 USD.
+func AmountFromMinorUnits(units int64, currency Currency) Amount {
+	return Amount{
+		quantity: Decimal{units: units, precision: currency.precision},
+		currency: currency,
+	}
+}
+
+// Quantity returns the numeric value of the Amount.
+func (a Amount) Quantity() Decimal {
+	return a.quantity
+}
+
+// Currency returns the currency of the Amount.
+func (a Amount) Currency() Currency {
+	return a.currency
+}
+
+// MinorUnits returns the Amount as a count of the currency's minor units, e.g. cents.
+// Example: 12.34 USD is 1234.
+func (a Amount) MinorUnits() int64 {
+	return a.quantity.units
+}
+
 // String implements the Stringer interface for Amount.
-func (a *Amount) String() string {
-	return fmt.Sprintf("%s %s", &a.quantity, a.currency)
+func (a Amount) String() string {
+	return fmt.Sprintf("%s %s", a.quantity, a.currency)
+}
+
+// Add returns the sum of two Amounts in the same currency.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if err := a.checkCurrency(b); err != nil {
+		return Amount{}, err
+	}
+
+	sum, err := add(a.quantity, b.quantity)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return Amount{quantity: sum, currency: a.currency}, nil
+}
+
+// Sub returns the difference of two Amounts in the same currency.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if err := a.checkCurrency(b); err != nil {
+		return Amount{}, err
+	}
+
+	difference, err := sub(a.quantity, b.quantity)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return Amount{quantity: difference, currency: a.currency}, nil
+}
+
+// Neg returns the Amount with its sign reversed.
+// ErrOverflow is returned for the smallest Amount, whose positive value does not fit.
+func (a Amount) Neg() (Amount, error) {
+	if a.quantity.units == math.MinInt64 {
+		return Amount{}, ErrOverflow
+	}
+
+	a.quantity.units = -a.quantity.units
+	return a, nil
+}
+
+// Abs returns the absolute value of the Amount.
+// ErrOverflow is returned for the smallest Amount, whose positive value does not fit.
+func (a Amount) Abs() (Amount, error) {
+	if a.IsNegative() {
+		return a.Neg()
+	}
+
+	return a, nil
+}
+
+// MulDecimal multiplies the Amount by a Decimal, rounding the result to the precision of the currency.
+func (a Amount) MulDecimal(d Decimal, mode RoundingMode) (Amount, error) {
+	return a.mulRat(big.NewInt(d.units), bigPow10(int(d.precision)), mode)
+}
+
+// mulRat multiplies the Amount by the fraction numerator / denominator, rounding the result to the
+// precision of the currency. The denominator must be positive.
+func (a Amount) mulRat(numerator, denominator *big.Int, mode RoundingMode) (Amount, error) {
+	product := new(big.Int).Mul(big.NewInt(a.quantity.units), numerator)
+	units := divRound(product, denominator, mode)
+
+	if !units.IsInt64() {
+		return Amount{}, ErrOverflow
+	}
+
+	quantity := Decimal{units: units.Int64(), precision: a.quantity.precision}
+	return Amount{quantity: quantity, currency: a.currency}, nil
+}
+
+// Cmp compares two Amounts in the same currency and returns -1, 0 or +1
+// depending on whether a is less than, equal to or greater than b.
+func (a Amount) Cmp(b Amount) (int, error) {
+	if err := a.checkCurrency(b); err != nil {
+		return 0, err
+	}
+
+	return compare(a.quantity, b.quantity), nil
+}
+
+// IsZero reports whether the Amount is zero.
+func (a Amount) IsZero() bool {
+	return a.quantity.units == 0
+}
+
+// IsNegative reports whether the Amount is less than zero.
+func (a Amount) IsNegative() bool {
+	return a.quantity.units < 0
+}
+
+// Equal reports whether two Amounts have the same currency and value.
+func (a Amount) Equal(b Amount) bool {
+	return a.currency.code == b.currency.code && compare(a.quantity, b.quantity) == 0
+}
+
+// checkCurrency returns an error when the Amounts are not in the same currency.
+func (a Amount) checkCurrency(b Amount) error {
+	if a.currency.code != b.currency.code {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.currency, b.currency)
+	}
+
+	return nil
 }
@@ -2,6 +2,7 @@ package money
 
 import (
 	"errors"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -39,6 +40,12 @@ func TestNewAmount(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"decimal too large for the currency precision": {
+			decimal:  Decimal{units: 100_000_000_000_000_000, precision: 0},
+			currency: Currency{code: "USD", precision: 2},
+			want:     Amount{},
+			wantErr:  ErrOverflow,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -53,3 +60,329 @@ func TestNewAmount(t *testing.T) {
 		})
 	}
 }
+
+// usd creates an Amount in US Dollars from its units of cents.
+func usd(cents int64) Amount {
+	return Amount{
+		quantity: Decimal{units: cents, precision: 2},
+		currency: Currency{code: "USD", precision: 2},
+	}
+}
+
+// eur creates an Amount in Euro from its units of cents.
+func eur(cents int64) Amount {
+	return Amount{
+		quantity: Decimal{units: cents, precision: 2},
+		currency: Currency{code: "EUR", precision: 2},
+	}
+}
+
+func TestAmountAddSub(t *testing.T) {
+	type testCase struct {
+		a, b       Amount
+		wantAdd    Amount
+		wantAddErr error
+		wantSub    Amount
+		wantSubErr error
+	}
+
+	testCases := map[string]testCase{
+		"same currency": {
+			a:       usd(1050),
+			b:       usd(225),
+			wantAdd: usd(1275),
+			wantSub: usd(825),
+		},
+		"negative result": {
+			a:       usd(100),
+			b:       usd(250),
+			wantAdd: usd(350),
+			wantSub: usd(-150),
+		},
+		"different currencies": {
+			a:          usd(100),
+			b:          eur(100),
+			wantAddErr: ErrCurrencyMismatch,
+			wantSubErr: ErrCurrencyMismatch,
+		},
+		"overflow": {
+			a:          usd(math.MaxInt64),
+			b:          usd(-1),
+			wantAdd:    usd(math.MaxInt64 - 1),
+			wantSubErr: ErrOverflow,
+		},
+		"subtracting the smallest value": {
+			a:          usd(-1),
+			b:          usd(math.MinInt64),
+			wantAddErr: ErrOverflow,
+			wantSub:    usd(math.MaxInt64),
+		},
+		"subtracting the smallest value overflows": {
+			a:          usd(0),
+			b:          usd(math.MinInt64),
+			wantAdd:    usd(math.MinInt64),
+			wantSubErr: ErrOverflow,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotAdd, err := tc.a.Add(tc.b)
+			if !errors.Is(err, tc.wantAddErr) {
+				t.Errorf("Add got err: %v, want: %v", err, tc.wantAddErr)
+			}
+			if !reflect.DeepEqual(gotAdd, tc.wantAdd) {
+				t.Errorf("Add got: %#v, want: %#v", gotAdd, tc.wantAdd)
+			}
+
+			gotSub, err := tc.a.Sub(tc.b)
+			if !errors.Is(err, tc.wantSubErr) {
+				t.Errorf("Sub got err: %v, want: %v", err, tc.wantSubErr)
+			}
+			if !reflect.DeepEqual(gotSub, tc.wantSub) {
+				t.Errorf("Sub got: %#v, want: %#v", gotSub, tc.wantSub)
+			}
+		})
+	}
+}
+
+func TestAmountNegAbs(t *testing.T) {
+	type testCase struct {
+		in      Amount
+		wantNeg Amount
+		wantAbs Amount
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"positive": {
+			in:      usd(125),
+			wantNeg: usd(-125),
+			wantAbs: usd(125),
+		},
+		"negative": {
+			in:      usd(-125),
+			wantNeg: usd(125),
+			wantAbs: usd(125),
+		},
+		"zero": {
+			in:      usd(0),
+			wantNeg: usd(0),
+			wantAbs: usd(0),
+		},
+		"largest": {
+			in:      usd(math.MaxInt64),
+			wantNeg: usd(-math.MaxInt64),
+			wantAbs: usd(math.MaxInt64),
+		},
+		"smallest overflows": {
+			in:      usd(math.MinInt64),
+			wantErr: ErrOverflow,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotNeg, err := tc.in.Neg()
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("Neg got err: %v, want: %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(gotNeg, tc.wantNeg) {
+				t.Errorf("Neg got: %#v, want: %#v", gotNeg, tc.wantNeg)
+			}
+
+			gotAbs, err := tc.in.Abs()
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("Abs got err: %v, want: %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(gotAbs, tc.wantAbs) {
+				t.Errorf("Abs got: %#v, want: %#v", gotAbs, tc.wantAbs)
+			}
+		})
+	}
+}
+
+func TestAmountMulDecimal(t *testing.T) {
+	type testCase struct {
+		in      Amount
+		factor  Decimal
+		mode    RoundingMode
+		want    Amount
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"exact": {
+			in:     usd(1000),
+			factor: Decimal{units: 15, precision: 1},
+			mode:   RoundHalfEven,
+			want:   usd(1500),
+		},
+		"keeps the currency precision": {
+			in:     usd(1000),
+			factor: Decimal{units: 3333, precision: 4},
+			mode:   RoundHalfEven,
+			want:   usd(333),
+		},
+		"tie half even": {
+			in:     usd(5),
+			factor: Decimal{units: 5, precision: 1},
+			mode:   RoundHalfEven,
+			want:   usd(2),
+		},
+		"tie half up": {
+			in:     usd(5),
+			factor: Decimal{units: 5, precision: 1},
+			mode:   RoundHalfUp,
+			want:   usd(3),
+		},
+		"negative factor": {
+			in:     usd(1000),
+			factor: Decimal{units: -2, precision: 0},
+			mode:   RoundHalfEven,
+			want:   usd(-2000),
+		},
+		"overflow": {
+			in:      usd(math.MaxInt64),
+			factor:  Decimal{units: 2, precision: 0},
+			mode:    RoundHalfEven,
+			want:    Amount{},
+			wantErr: ErrOverflow,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.MulDecimal(tc.factor, tc.mode)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountComparisons(t *testing.T) {
+	type testCase struct {
+		a, b         Amount
+		wantCmp      int
+		wantErr      error
+		wantEqual    bool
+		wantZero     bool
+		wantNegative bool
+	}
+
+	testCases := map[string]testCase{
+		"less": {
+			a:            usd(-100),
+			b:            usd(100),
+			wantCmp:      -1,
+			wantNegative: true,
+		},
+		"greater": {
+			a:       usd(101),
+			b:       usd(100),
+			wantCmp: 1,
+		},
+		"equal zero": {
+			a:         usd(0),
+			b:         usd(0),
+			wantCmp:   0,
+			wantEqual: true,
+			wantZero:  true,
+		},
+		"different currencies": {
+			a:       usd(100),
+			b:       eur(100),
+			wantErr: ErrCurrencyMismatch,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.a.Cmp(tc.b)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if got != tc.wantCmp {
+				t.Errorf("Cmp got: %d, want: %d", got, tc.wantCmp)
+			}
+			if got := tc.a.Equal(tc.b); got != tc.wantEqual {
+				t.Errorf("Equal got: %t, want: %t", got, tc.wantEqual)
+			}
+			if got := tc.a.IsZero(); got != tc.wantZero {
+				t.Errorf("IsZero got: %t, want: %t", got, tc.wantZero)
+			}
+			if got := tc.a.IsNegative(); got != tc.wantNegative {
+				t.Errorf("IsNegative got: %t, want: %t", got, tc.wantNegative)
+			}
+		})
+	}
+}
+
+func TestAmountFromMinorUnits(t *testing.T) {
+	type testCase struct {
+		units    int64
+		currency Currency
+		want     Amount
+	}
+
+	testCases := map[string]testCase{
+		"cents": {
+			units:    1234,
+			currency: Currency{code: "USD", precision: 2},
+			want:     usd(1234),
+		},
+		"no minor unit": {
+			units:    1234,
+			currency: Currency{code: "IRR", precision: 0},
+			want: Amount{
+				quantity: Decimal{units: 1234, precision: 0},
+				currency: Currency{code: "IRR", precision: 0},
+			},
+		},
+		"three decimal places": {
+			units:    -1234,
+			currency: Currency{code: "KWD", precision: 3},
+			want: Amount{
+				quantity: Decimal{units: -1234, precision: 3},
+				currency: Currency{code: "KWD", precision: 3},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := AmountFromMinorUnits(tc.units, tc.currency)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			}
+			if got.MinorUnits() != tc.units {
+				t.Errorf("got minor units: %d, want: %d", got.MinorUnits(), tc.units)
+			}
+			if got.Currency() != tc.currency {
+				t.Errorf("got currency: %#v, want: %#v", got.Currency(), tc.currency)
+			}
+			if got.Quantity() != tc.want.quantity {
+				t.Errorf("got quantity: %#v, want: %#v", got.Quantity(), tc.want.quantity)
+			}
+		})
+	}
+}
+
+func TestAmountMinorUnitsFromNewAmount(t *testing.T) {
+	amount, err := NewAmount(Decimal{units: 5, precision: 0}, Currency{code: "USD", precision: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if got := amount.MinorUnits(); got != 500 {
+		t.Errorf("got: %d, want: %d", got, 500)
+	}
+
+	if got := amount.Currency().Precision(); got != 2 {
+		t.Errorf("got precision: %d, want: %d", got, 2)
+	}
+}
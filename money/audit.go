@@ -0,0 +1,119 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// RateQuote is an exchange rate together with a description of where it comes from.
+type RateQuote struct {
+	// Rate is the exchange rate from the source to the target currency.
+	Rate ExchangeRate
+	// Source names the publisher of the rate, e.g. "ECB", or is empty when unknown.
+	Source string
+	// Date is the day the rate applies to, or the zero time when unknown.
+	Date time.Time
+	// Path lists the currencies from the source to the target, including any pegs or pivots the rate
+	// was derived through. A direct quote has a path of length 2.
+	Path []Currency
+}
+
+// ConversionResult records how an Amount was converted, for auditing.
+type ConversionResult struct {
+	// Input is the Amount that was converted.
+	Input Amount `json:"input"`
+	// Result is the converted Amount, rounded to the precision of its currency.
+	Result Amount `json:"result"`
+	// Rate is the exchange rate as applied.
+	Rate Decimal `json:"rate"`
+	// Source names the publisher of the rate, or is empty when the provider does not report it.
+	Source string `json:"source,omitempty"`
+	// RateDate is the day the rate applies to, or the zero time when the provider does not report it.
+	RateDate time.Time `json:"rateDate"`
+	// Path lists the currencies the rate was derived through, from the input to the result currency.
+	Path []Currency `json:"path"`
+	// Unrounded is the exact product of the input quantity and the rate, written as a decimal, e.g. "5.005".
+	// It is text because the product can have more digits than a Decimal holds.
+	Unrounded string `json:"unrounded"`
+	// Rounding is the rounding mode used to get the result from the unrounded value.
+	Rounding RoundingMode `json:"rounding"`
+	// Residual is the part of the unrounded value dropped by rounding, Unrounded - Result, written like Unrounded.
+	Residual string `json:"residual"`
+}
+
+// ConvertDetailed converts the Amount to the target currency like Convert, rounding with the rounding mode,
+// and records the rate, its origin and the rounding applied. Convert truncates, which is RoundDown.
+// Providers that implement FetchQuote(source, target Currency) (RateQuote, error) can report the source
+// and date of their rates.
+func ConvertDetailed(amount Amount, to Currency, rates exchangeRates, mode RoundingMode) (ConversionResult, error) {
+	quote, err := fetchQuote(rates, amount.currency, to)
+	if err != nil {
+		return ConversionResult{}, fmt.Errorf("cannot get exchange rate: %w", err)
+	}
+
+	rate, err := exchangeRateDecimal(quote.Rate)
+	if err != nil {
+		return ConversionResult{}, err
+	}
+
+	result, err := convertQuantity(amount.quantity, rate, to, mode)
+	if err != nil {
+		return ConversionResult{}, err
+	}
+
+	// the product and the result are compared at the larger of their precisions.
+	precision := max(int(amount.quantity.precision)+int(rate.precision), int(to.precision))
+
+	unrounded := new(big.Int).Mul(big.NewInt(amount.quantity.units), big.NewInt(rate.units))
+	unrounded.Mul(unrounded, bigPow10(precision-int(amount.quantity.precision)-int(rate.precision)))
+
+	rounded := new(big.Int).Mul(big.NewInt(result.quantity.units), bigPow10(precision-int(to.precision)))
+	residual := new(big.Int).Sub(unrounded, rounded)
+
+	return ConversionResult{
+		Input:     amount,
+		Result:    result,
+		Rate:      rate,
+		Source:    quote.Source,
+		RateDate:  quote.Date,
+		Path:      quote.Path,
+		Unrounded: formatExact(unrounded, precision),
+		Rounding:  mode,
+		Residual:  formatExact(residual, precision),
+	}, nil
+}
+
+// formatExact writes units * 10^-precision as a decimal without trailing zeroes, e.g. 5.005.
+func formatExact(units *big.Int, precision int) string {
+	units = new(big.Int).Set(units)
+
+	ten, quotient, remainder := big.NewInt(10), new(big.Int), new(big.Int)
+	for precision > 0 {
+		quotient.QuoRem(units, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+
+		units.Set(quotient)
+		precision--
+	}
+
+	sign := ""
+	if units.Sign() < 0 {
+		sign = "-"
+	}
+
+	digits := new(big.Int).Abs(units).String()
+	if precision == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= precision {
+		digits = strings.Repeat("0", precision-len(digits)+1) + digits
+	}
+
+	split := len(digits) - precision
+	return sign + digits[:split] + "." + digits[split:]
+}
@@ -0,0 +1,221 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeQuotes is a provider that describes its rates with a source and date.
+type fakeQuotes struct {
+	rates  fakeRates
+	source string
+	date   time.Time
+}
+
+// FetchExchangeRate implements the exchangeRates interface.
+func (f fakeQuotes) FetchExchangeRate(source, target Currency) (ExchangeRate, error) {
+	return f.rates.FetchExchangeRate(source, target)
+}
+
+// FetchQuote implements the quoteProvider interface.
+func (f fakeQuotes) FetchQuote(source, target Currency) (RateQuote, error) {
+	rate, err := f.rates.FetchExchangeRate(source, target)
+	if err != nil {
+		return RateQuote{}, err
+	}
+
+	return RateQuote{Rate: rate, Source: f.source, Date: f.date}, nil
+}
+
+func TestConvertDetailed(t *testing.T) {
+	date := time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC)
+	bmd := mustCurrency(t, "BMD")
+
+	type testCase struct {
+		amount Amount
+		rates  exchangeRates
+		mode   RoundingMode
+		want   ConversionResult
+	}
+
+	testCases := map[string]testCase{
+		"quote with source and date": {
+			amount: usd(1001),
+			rates:  fakeQuotes{rates: fakeRates{"USD/EUR": 0.5}, source: "TEST", date: date},
+			mode:   RoundHalfUp,
+			want: ConversionResult{
+				Input:     usd(1001),
+				Result:    eur(501),
+				Rate:      Decimal{units: 5, precision: 1},
+				Source:    "TEST",
+				RateDate:  date,
+				Path:      []Currency{mustCurrency(t, "USD"), mustCurrency(t, "EUR")},
+				Unrounded: "5.005",
+				Rounding:  RoundHalfUp,
+				Residual:  "-0.005",
+			},
+		},
+		"truncated like Convert": {
+			amount: usd(1001),
+			rates:  fakeRates{"USD/EUR": 0.5},
+			mode:   RoundDown,
+			want: ConversionResult{
+				Input:     usd(1001),
+				Result:    eur(500),
+				Rate:      Decimal{units: 5, precision: 1},
+				Path:      []Currency{mustCurrency(t, "USD"), mustCurrency(t, "EUR")},
+				Unrounded: "5.005",
+				Rounding:  RoundDown,
+				Residual:  "0.005",
+			},
+		},
+		"pegged currency": {
+			amount: AmountFromMinorUnits(1000, bmd),
+			rates:  fakeQuotes{rates: fakeRates{"USD/EUR": 0.5}, source: "TEST", date: date},
+			mode:   RoundHalfEven,
+			want: ConversionResult{
+				Input:     AmountFromMinorUnits(1000, bmd),
+				Result:    eur(500),
+				Rate:      Decimal{units: 5, precision: 1},
+				Source:    "TEST",
+				RateDate:  date,
+				Path:      []Currency{bmd, mustCurrency(t, "USD"), mustCurrency(t, "EUR")},
+				Unrounded: "5",
+				Rounding:  RoundHalfEven,
+				Residual:  "0",
+			},
+		},
+		"triangulated": {
+			amount: eur(1000),
+			rates: NewTriangulator(
+				[]Currency{mustCurrency(t, "USD")},
+				fakeRates{"EUR/USD": 2, "USD/GBP": 0.25},
+			),
+			mode: RoundHalfEven,
+			want: ConversionResult{
+				Input:     eur(1000),
+				Result:    AmountFromMinorUnits(500, mustCurrency(t, "GBP")),
+				Rate:      Decimal{units: 5, precision: 1},
+				Path:      []Currency{mustCurrency(t, "EUR"), mustCurrency(t, "USD"), mustCurrency(t, "GBP")},
+				Unrounded: "5",
+				Rounding:  RoundHalfEven,
+				Residual:  "0",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ConvertDetailed(tc.amount, tc.want.Result.currency, tc.rates, tc.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %+v, want: %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertDetailed_MatchesConvert(t *testing.T) {
+	rates := fakeRates{"USD/EUR": 0.87}
+	eurCurrency := mustCurrency(t, "EUR")
+
+	for _, amount := range []Amount{usd(1), usd(999), usd(-1234)} {
+		want, err := Convert(amount, eurCurrency, rates)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		got, err := ConvertDetailed(amount, eurCurrency, rates, RoundDown)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if !reflect.DeepEqual(got.Result, want) {
+			t.Errorf("got: %s, want: %s", got.Result, want)
+		}
+	}
+}
+
+func TestConvertDetailed_CrossRate(t *testing.T) {
+	// a rate derived by division has as many digits as a float can hold.
+	rates := fakeRates{"USD/EUR": 1 / ExchangeRate(1.08)}
+	eurCurrency := mustCurrency(t, "EUR")
+
+	type testCase struct {
+		amount    Amount
+		want      Amount
+		unrounded string
+		residual  string
+	}
+
+	testCases := map[string]testCase{
+		"12.34 USD":   {amount: usd(1234), want: eur(1142), unrounded: "11.425925925925924372", residual: "0.005925925925924372"},
+		"999.99 USD":  {amount: usd(99999), want: eur(92591), unrounded: "925.916666666666540742", residual: "0.006666666666540742"},
+		"1234.56 USD": {amount: usd(123456), want: eur(114311), unrounded: "1143.111111111110955648", residual: "0.001111111110955648"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ConvertDetailed(tc.amount, eurCurrency, rates, RoundDown)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(got.Result, tc.want) {
+				t.Errorf("got: %s, want: %s", got.Result, tc.want)
+			}
+
+			if got.Unrounded != tc.unrounded || got.Residual != tc.residual {
+				t.Errorf("got unrounded %s and residual %s, want %s and %s", got.Unrounded, got.Residual, tc.unrounded, tc.residual)
+			}
+		})
+	}
+}
+
+func TestConvertDetailed_RateNotFound(t *testing.T) {
+	_, err := ConvertDetailed(usd(100), mustCurrency(t, "GBP"), fakeRates{}, RoundHalfEven)
+	if !errors.Is(err, errFakeRateNotFound) {
+		t.Errorf("got err: %v, want: %v", err, errFakeRateNotFound)
+	}
+}
+
+func TestConversionResultJSON(t *testing.T) {
+	rates := fakeQuotes{
+		rates:  fakeRates{"USD/EUR": 0.5},
+		source: "TEST",
+		date:   time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := ConvertDetailed(usd(1001), mustCurrency(t, "EUR"), rates, RoundHalfEven)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	got, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	want := `{"input":{"amount":"10.01","currency":"USD"},"result":{"amount":"5.00","currency":"EUR"},` +
+		`"rate":"0.5","source":"TEST","rateDate":"2024-05-17T00:00:00Z","path":["USD","EUR"],` +
+		`"unrounded":"5.005","rounding":"half-even","residual":"0.005"}`
+
+	if string(got) != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+
+	var decoded ConversionResult
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !reflect.DeepEqual(decoded, result) {
+		t.Errorf("got: %+v, want: %+v", decoded, result)
+	}
+}
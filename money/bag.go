@@ -0,0 +1,129 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Bag holds Amounts in multiple currencies, such as the balances of a wallet.
+// The zero value is an empty Bag ready to use.
+type Bag struct {
+	holdings map[string]Amount
+}
+
+// NewBag creates a Bag holding the sum of the amounts.
+func NewBag(amounts ...Amount) (*Bag, error) {
+	bag := &Bag{}
+	for _, amount := range amounts {
+		if err := bag.Add(amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return bag, nil
+}
+
+// Add adds the Amount to the holding in its currency.
+func (b *Bag) Add(amount Amount) error {
+	current, found := b.holdings[amount.currency.code]
+	if !found {
+		current = Amount{quantity: Decimal{precision: amount.quantity.precision}, currency: amount.currency}
+	}
+
+	sum, err := current.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	b.set(sum)
+	return nil
+}
+
+// Sub subtracts the Amount from the holding in its currency.
+func (b *Bag) Sub(amount Amount) error {
+	current, found := b.holdings[amount.currency.code]
+	if !found {
+		current = Amount{quantity: Decimal{precision: amount.quantity.precision}, currency: amount.currency}
+	}
+
+	difference, err := current.Sub(amount)
+	if err != nil {
+		return err
+	}
+
+	b.set(difference)
+	return nil
+}
+
+// Amount returns the holding in the currency, which is zero when the Bag holds none of it.
+func (b *Bag) Amount(currency Currency) Amount {
+	if holding, found := b.holdings[currency.code]; found {
+		return holding
+	}
+
+	return AmountFromMinorUnits(0, currency)
+}
+
+// Amounts returns the non-zero holdings ordered by currency code.
+func (b *Bag) Amounts() []Amount {
+	amounts := make([]Amount, 0, len(b.holdings))
+	for _, holding := range b.holdings {
+		amounts = append(amounts, holding)
+	}
+
+	sort.Slice(amounts, func(i, j int) bool {
+		return amounts[i].currency.code < amounts[j].currency.code
+	})
+
+	return amounts
+}
+
+// Len returns the number of currencies with a non-zero holding.
+func (b *Bag) Len() int {
+	return len(b.holdings)
+}
+
+// Value converts every holding to the target currency and returns their sum.
+// Each holding is converted the same way as Convert. Providers that can return all of their rates
+// in a single call, such as a RateTable or a provider with a FetchRateTable method, are only called once.
+func (b *Bag) Value(target Currency, rates exchangeRates) (Amount, error) {
+	provider, err := batched(rates)
+	if err != nil {
+		return Amount{}, fmt.Errorf("cannot get exchange rates: %w", err)
+	}
+
+	total := AmountFromMinorUnits(0, target)
+
+	for _, holding := range b.Amounts() {
+		rate, err := fetchExchangeRate(provider, holding.currency, target)
+		if err != nil {
+			return Amount{}, fmt.Errorf("cannot get exchange rate for %s: %w", holding.currency, err)
+		}
+
+		converted, err := applyExchangeRate(holding, target, rate)
+		if err != nil {
+			return Amount{}, err
+		}
+
+		total, err = total.Add(converted)
+		if err != nil {
+			return Amount{}, err
+		}
+	}
+
+	return total, nil
+}
+
+// set stores the holding, removing it when it is zero.
+func (b *Bag) set(holding Amount) {
+	if holding.IsZero() {
+		delete(b.holdings, holding.currency.code)
+		return
+	}
+
+	if b.holdings == nil {
+		b.holdings = make(map[string]Amount)
+	}
+
+	b.holdings[holding.currency.code] = holding
+}
@@ -0,0 +1,143 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// countingTable is a provider that can return all of its rates in a single call and counts its calls.
+type countingTable struct {
+	table      RateTable
+	tableCalls int
+	rateCalls  int
+}
+
+// FetchExchangeRate implements the exchangeRates interface.
+func (c *countingTable) FetchExchangeRate(source, target Currency) (ExchangeRate, error) {
+	c.rateCalls++
+	return c.table.FetchExchangeRate(source, target)
+}
+
+// FetchRateTable implements the rateTableProvider interface.
+func (c *countingTable) FetchRateTable() (RateTable, error) {
+	c.tableCalls++
+	return c.table, nil
+}
+
+func TestBagAddSub(t *testing.T) {
+	bag, err := NewBag(usd(1000), eur(500), usd(250))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := bag.Sub(eur(500)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := bag.Sub(Amount{quantity: Decimal{units: 125, precision: 2}, currency: Currency{code: "GBP", precision: 2}}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	want := []Amount{
+		{quantity: Decimal{units: -125, precision: 2}, currency: Currency{code: "GBP", precision: 2}},
+		usd(1250),
+	}
+
+	if got := bag.Amounts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	if got := bag.Len(); got != 2 {
+		t.Errorf("got len: %d, want: %d", got, 2)
+	}
+
+	if got := bag.Amount(Currency{code: "EUR", precision: 2}); !reflect.DeepEqual(got, eur(0)) {
+		t.Errorf("got: %#v, want: %#v", got, eur(0))
+	}
+
+	if got := bag.Amount(Currency{code: "USD", precision: 2}); !reflect.DeepEqual(got, usd(1250)) {
+		t.Errorf("got: %#v, want: %#v", got, usd(1250))
+	}
+}
+
+func TestBagOverflow(t *testing.T) {
+	var bag Bag
+
+	if err := bag.Add(usd(1 << 62)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := bag.Add(usd(1 << 62)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got err: %v, want: %v", err, ErrOverflow)
+	}
+
+	if got := bag.Amount(Currency{code: "USD", precision: 2}); !reflect.DeepEqual(got, usd(1<<62)) {
+		t.Errorf("a failed Add changed the holding: %#v", got)
+	}
+}
+
+func TestBagValue(t *testing.T) {
+	gbp := Currency{code: "GBP", precision: 2}
+
+	type testCase struct {
+		amounts []Amount
+		rates   exchangeRates
+		want    Amount
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"empty bag": {
+			rates: fakeRates{},
+			want:  eur(0),
+		},
+		"single call provider": {
+			amounts: []Amount{usd(1000), eur(250), AmountFromMinorUnits(400, gbp)},
+			rates: &countingTable{table: RateTable{
+				Base:  "EUR",
+				Rates: map[string]ExchangeRate{"USD": 2, "GBP": 0.8},
+			}},
+			// 5.00 + 2.50 + 5.00
+			want: eur(1250),
+		},
+		"per currency provider": {
+			amounts: []Amount{usd(1000), eur(250)},
+			rates:   fakeRates{"USD/EUR": 0.5},
+			want:    eur(750),
+		},
+		"pegged currency": {
+			amounts: []Amount{AmountFromMinorUnits(200, Currency{code: "BMD", precision: 2})},
+			rates:   fakeRates{"USD/EUR": 0.5},
+			want:    eur(100),
+		},
+		"missing rate": {
+			amounts: []Amount{usd(1000), AmountFromMinorUnits(400, gbp)},
+			rates:   fakeRates{"USD/EUR": 0.5},
+			wantErr: errFakeRateNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bag, err := NewBag(tc.amounts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			got, err := bag.Value(Currency{code: "EUR", precision: 2}, tc.rates)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %s, want: %s", &got, &tc.want)
+			}
+
+			if counting, ok := tc.rates.(*countingTable); ok {
+				if counting.tableCalls != 1 || counting.rateCalls != 0 {
+					t.Errorf("got %d table calls and %d rate calls, want a single table call", counting.tableCalls, counting.rateCalls)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package money
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// defaultWorkers is the number of rates a Converter fetches at the same time unless configured otherwise.
+const defaultWorkers = 4
+
+// BatchResult is the outcome of converting one Amount of a batch.
+type BatchResult struct {
+	// Amount is the converted Amount, it is the zero value when Err is set.
+	Amount Amount
+	// Err is the reason the Amount could not be converted.
+	Err error
+}
+
+// Converter converts batches of Amounts, fetching the rate of each distinct currency pair only once.
+// Rates are fetched concurrently, so the provider must be safe for concurrent use.
+type Converter struct {
+	rates   exchangeRates
+	workers int
+}
+
+// ConverterOption configures a Converter.
+type ConverterOption func(*Converter)
+
+// WithWorkers sets the number of rates fetched at the same time. Values below one are ignored.
+func WithWorkers(workers int) ConverterOption {
+	return func(c *Converter) {
+		if workers > 0 {
+			c.workers = workers
+		}
+	}
+}
+
+// NewConverter creates a Converter that gets its exchange rates from the provider.
+func NewConverter(rates exchangeRates, options ...ConverterOption) Converter {
+	converter := Converter{rates: rates, workers: defaultWorkers}
+	for _, option := range options {
+		option(&converter)
+	}
+
+	return converter
+}
+
+// ConvertAll converts every Amount to the target currency with a Converter using the provider.
+// See Converter.ConvertAll.
+func ConvertAll(ctx context.Context, amounts []Amount, to Currency, rates exchangeRates) []BatchResult {
+	return NewConverter(rates).ConvertAll(ctx, amounts, to)
+}
+
+// ConvertAll converts every Amount to the target currency and returns one result per Amount, in order.
+// A failure only affects the Amounts that depend on it, the rest of the batch is still converted.
+// Providers that can return all of their rates in a single call are called once.
+// Once the context is done no further rates are fetched and the affected Amounts report the context's error.
+func (c Converter) ConvertAll(ctx context.Context, amounts []Amount, to Currency) []BatchResult {
+	rates := c.fetchRates(ctx, amounts, to)
+
+	results := make([]BatchResult, len(amounts))
+	for i, amount := range amounts {
+		rate := rates[amount.currency.code]
+		if rate.err != nil {
+			results[i].Err = fmt.Errorf("cannot get exchange rate: %w", rate.err)
+			continue
+		}
+
+		results[i].Amount, results[i].Err = applyExchangeRate(amount, to, rate.rate)
+	}
+
+	return results
+}
+
+// fetchedRate is the outcome of fetching the rate from one source currency.
+type fetchedRate struct {
+	rate ExchangeRate
+	err  error
+}
+
+// fetchRates fetches the rate from each distinct source currency to the target using a pool of workers.
+func (c Converter) fetchRates(ctx context.Context, amounts []Amount, to Currency) map[string]fetchedRate {
+	sources := make(map[string]Currency)
+	for _, amount := range amounts {
+		if amount.currency.code != to.code {
+			sources[amount.currency.code] = amount.currency
+		}
+	}
+
+	fetched := make(map[string]fetchedRate, len(sources)+1)
+	fetched[to.code] = fetchedRate{rate: 1}
+
+	if len(sources) == 0 {
+		return fetched
+	}
+
+	provider, err := batched(c.rates)
+	if err != nil {
+		for code := range sources {
+			fetched[code] = fetchedRate{err: err}
+		}
+		return fetched
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		jobs = make(chan Currency)
+	)
+
+	for range min(c.workers, len(sources)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for source := range jobs {
+				var result fetchedRate
+				if err := ctx.Err(); err != nil {
+					result.err = err
+				} else {
+					result.rate, result.err = fetchExchangeRate(provider, source, to)
+				}
+
+				mu.Lock()
+				fetched[source.code] = result
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, source := range sources {
+		jobs <- source
+	}
+	close(jobs)
+	wg.Wait()
+
+	return fetched
+}
@@ -0,0 +1,108 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// countingRates is a provider that counts its calls per currency pair and is safe for concurrent use.
+type countingRates struct {
+	rates fakeRates
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// FetchExchangeRate implements the exchangeRates interface.
+func (c *countingRates) FetchExchangeRate(source, target Currency) (ExchangeRate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.calls == nil {
+		c.calls = make(map[string]int)
+	}
+	c.calls[source.code+"/"+target.code]++
+
+	return c.rates.FetchExchangeRate(source, target)
+}
+
+func TestConverterConvertAll(t *testing.T) {
+	gbp := func(pence int64) Amount { return AmountFromMinorUnits(pence, mustCurrency(t, "GBP")) }
+	chf := AmountFromMinorUnits(100, mustCurrency(t, "CHF"))
+
+	rates := &countingRates{rates: fakeRates{"USD/EUR": 0.5, "GBP/EUR": 2}}
+	amounts := []Amount{usd(1000), gbp(100), chf, usd(300), eur(150), gbp(50)}
+
+	got := NewConverter(rates, WithWorkers(2)).ConvertAll(context.Background(), amounts, mustCurrency(t, "EUR"))
+
+	want := []Amount{eur(500), eur(200), {}, eur(150), eur(150), eur(100)}
+	for i, result := range got {
+		if i == 2 {
+			if !errors.Is(result.Err, errFakeRateNotFound) {
+				t.Errorf("item %d: got err: %v, want: %v", i, result.Err, errFakeRateNotFound)
+			}
+			continue
+		}
+
+		if result.Err != nil {
+			t.Errorf("item %d: unexpected error: %s", i, result.Err.Error())
+		}
+
+		if !reflect.DeepEqual(result.Amount, want[i]) {
+			t.Errorf("item %d: got: %s, want: %s", i, result.Amount, want[i])
+		}
+	}
+
+	wantCalls := map[string]int{"USD/EUR": 1, "GBP/EUR": 1, "CHF/EUR": 1}
+	if !reflect.DeepEqual(rates.calls, wantCalls) {
+		t.Errorf("got calls: %v, want: %v", rates.calls, wantCalls)
+	}
+}
+
+func TestConvertAll_RateTable(t *testing.T) {
+	rates := &countingTable{table: RateTable{
+		Base:  "EUR",
+		Rates: map[string]ExchangeRate{"USD": 2, "GBP": 0.5},
+	}}
+
+	got := ConvertAll(context.Background(), []Amount{eur(100), eur(200)}, mustCurrency(t, "USD"), rates)
+
+	want := []BatchResult{{Amount: usd(200)}, {Amount: usd(400)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	if rates.tableCalls != 1 || rates.rateCalls != 0 {
+		t.Errorf("got %d table and %d rate calls, want a single table call", rates.tableCalls, rates.rateCalls)
+	}
+}
+
+func TestConvertAll_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rates := &countingRates{rates: fakeRates{"USD/EUR": 0.5}}
+	got := ConvertAll(ctx, []Amount{usd(100), eur(100)}, mustCurrency(t, "EUR"), rates)
+
+	if !errors.Is(got[0].Err, context.Canceled) {
+		t.Errorf("got err: %v, want: %v", got[0].Err, context.Canceled)
+	}
+
+	// amounts already in the target currency need no rate.
+	if got[1].Err != nil || !reflect.DeepEqual(got[1].Amount, eur(100)) {
+		t.Errorf("got: %v, want: %s", got[1], eur(100))
+	}
+
+	if len(rates.calls) != 0 {
+		t.Errorf("got calls: %v, want none", rates.calls)
+	}
+}
+
+func TestConvertAll_Empty(t *testing.T) {
+	if got := ConvertAll(context.Background(), nil, mustCurrency(t, "EUR"), fakeRates{}); len(got) != 0 {
+		t.Errorf("got: %v, want no results", got)
+	}
+}
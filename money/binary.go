@@ -0,0 +1,163 @@
+package money
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+// The binary encoding of a Decimal is a header byte followed by the units:
+//
+//	header: bit 7 is the big units flag, bits 0-6 are the scale (0-127)
+//	units:  when the flag is clear, the units as a zigzag varint (encoding/binary.AppendVarint)
+//	        when the flag is set, a sign byte (0 positive, 1 negative), the length of the magnitude
+//	        as a uvarint and the magnitude as big-endian bytes
+//
+// e.g. 123.45 is 02 f2 c0 01. The big form is a reserved escape for units wider than an int64 and
+// encoders never emit it. Decoders reject it with ErrInvalidBinary when the units fit the varint form,
+// so that every value has a single encoding, and with ErrOverflow when they do not fit a Decimal.
+//
+// The binary encoding of an Amount is the ISO 4217 numeric code of its currency as a uvarint followed by
+// its quantity as a Decimal, e.g. 12.34 USD is c8 06 02 a4 13. Currencies without a known numeric code
+// are written as the numeric code 0 followed by the three ASCII letters of their code.
+
+const (
+	// ErrInvalidBinary is returned when binary data is not a valid encoding.
+	ErrInvalidBinary = Error("invalid binary encoding")
+)
+
+const (
+	// bigUnitsFlag marks a Decimal header whose units are written as a big integer.
+	bigUnitsFlag = 0x80
+	// alphabeticCode is the numeric code written before currency codes that have no numeric code.
+	alphabeticCode = 0
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	return d.appendBinary(nil)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	decimal, n, err := decodeDecimal(data)
+	if err != nil {
+		return err
+	}
+
+	if n != len(data) {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidBinary, len(data)-n)
+	}
+
+	*d = decimal
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (a Amount) MarshalBinary() ([]byte, error) {
+	if err := validateCurrencyCode(a.currency.code); err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if numeric, found := a.currency.NumericCode(); found {
+		data = binary.AppendUvarint(data, uint64(numeric))
+	} else {
+		data = append(binary.AppendUvarint(data, alphabeticCode), a.currency.code...)
+	}
+
+	return a.quantity.appendBinary(data)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (a *Amount) UnmarshalBinary(data []byte) error {
+	numeric, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("%w: malformed currency", ErrInvalidBinary)
+	}
+	data = data[n:]
+
+	var code string
+	switch {
+	case numeric == alphabeticCode:
+		if len(data) < 3 {
+			return fmt.Errorf("%w: truncated currency code", ErrInvalidBinary)
+		}
+		code, data = string(data[:3]), data[3:]
+	case numeric <= 999 && isoAlphabeticCodes[uint16(numeric)] != "":
+		code = isoAlphabeticCodes[uint16(numeric)]
+	default:
+		return fmt.Errorf("%w: unknown numeric currency code %d", ErrInvalidBinary, numeric)
+	}
+
+	currency, err := ParseCurrency(code)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBinary, err)
+	}
+
+	var quantity Decimal
+	if err := quantity.UnmarshalBinary(data); err != nil {
+		return err
+	}
+
+	amount, err := NewAmount(quantity, currency)
+	if err != nil {
+		return err
+	}
+
+	*a = amount
+	return nil
+}
+
+// appendBinary appends the binary encoding of the Decimal to the data.
+func (d Decimal) appendBinary(data []byte) ([]byte, error) {
+	if d.precision >= bigUnitsFlag {
+		return nil, fmt.Errorf("%w: scale %d does not fit in the header", ErrInvalidBinary, d.precision)
+	}
+
+	data = append(data, d.precision)
+	return binary.AppendVarint(data, d.units), nil
+}
+
+// decodeDecimal reads a Decimal from the start of the data and returns the number of bytes read.
+func decodeDecimal(data []byte) (Decimal, int, error) {
+	if len(data) == 0 {
+		return Decimal{}, 0, fmt.Errorf("%w: missing decimal", ErrInvalidBinary)
+	}
+
+	header, read := data[0], 1
+	scale := header &^ bigUnitsFlag
+
+	if header&bigUnitsFlag == 0 {
+		units, n := binary.Varint(data[read:])
+		if n <= 0 {
+			return Decimal{}, 0, fmt.Errorf("%w: malformed units", ErrInvalidBinary)
+		}
+
+		return NewDecimal(units, scale), read + n, nil
+	}
+
+	if len(data) < read+1 || data[read] > 1 {
+		return Decimal{}, 0, fmt.Errorf("%w: malformed sign", ErrInvalidBinary)
+	}
+	negative := data[read] == 1
+	read++
+
+	length, n := binary.Uvarint(data[read:])
+	if n <= 0 || length > uint64(len(data)-read-n) {
+		return Decimal{}, 0, fmt.Errorf("%w: malformed units", ErrInvalidBinary)
+	}
+	read += n
+
+	units := new(big.Int).SetBytes(data[read : read+int(length)])
+	if negative {
+		units.Neg(units)
+	}
+	read += int(length)
+
+	if units.IsInt64() {
+		return Decimal{}, 0, fmt.Errorf("%w: big units %s fit the varint form", ErrInvalidBinary, units)
+	}
+
+	return Decimal{}, 0, ErrOverflow
+}
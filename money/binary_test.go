@@ -0,0 +1,232 @@
+package money_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+func TestDecimalBinary(t *testing.T) {
+	type testCase struct {
+		input money.Decimal
+		want  []byte
+	}
+
+	testCases := map[string]testCase{
+		"zero":     {input: money.NewDecimal(0, 0), want: []byte{0x00, 0x00}},
+		"fraction": {input: mustParseDecimal(t, "123.45"), want: []byte{0x02, 0xf2, 0xc0, 0x01}},
+		"negative": {input: mustParseDecimal(t, "-0.5"), want: []byte{0x01, 0x09}},
+		"smallest": {input: money.NewDecimal(-1<<63, 0), want: []byte{0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data, err := tc.input.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !bytes.Equal(data, tc.want) {
+				t.Errorf("got: % x, want: % x", data, tc.want)
+			}
+
+			var got money.Decimal
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got != tc.input {
+				t.Errorf("got: %s, want: %s", got, tc.input)
+			}
+		})
+	}
+}
+
+func TestDecimalUnmarshalBinary(t *testing.T) {
+	type testCase struct {
+		input   []byte
+		want    money.Decimal
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"big units that fit the varint form": {
+			input:   []byte{0x82, 0x01, 0x02, 0x30, 0x39},
+			wantErr: money.ErrInvalidBinary,
+		},
+		"big units overflow": {
+			input:   []byte{0x80, 0x00, 0x09, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			wantErr: money.ErrOverflow,
+		},
+		"trailing zeroes are removed": {
+			input: []byte{0x02, 0x14},
+			want:  mustParseDecimal(t, "0.1"),
+		},
+		"empty":               {input: nil, wantErr: money.ErrInvalidBinary},
+		"truncated units":     {input: []byte{0x02, 0xf2}, wantErr: money.ErrInvalidBinary},
+		"truncated big units": {input: []byte{0x80, 0x00, 0x02, 0x01}, wantErr: money.ErrInvalidBinary},
+		"invalid sign":        {input: []byte{0x80, 0x02, 0x00}, wantErr: money.ErrInvalidBinary},
+		"trailing bytes":      {input: []byte{0x00, 0x02, 0x00}, wantErr: money.ErrInvalidBinary},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got money.Decimal
+			err := got.UnmarshalBinary(tc.input)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+
+			if got != tc.want {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountBinary(t *testing.T) {
+	type testCase struct {
+		input money.Amount
+		want  []byte
+	}
+
+	testCases := map[string]testCase{
+		"numeric code": {
+			input: mustParseAmount(t, "12.34", "USD"),
+			want:  []byte{0xc8, 0x06, 0x02, 0xa4, 0x13},
+		},
+		"single byte numeric code": {
+			input: mustParseAmount(t, "-1", "ALL"),
+			want:  []byte{0x08, 0x02, 0xc7, 0x01},
+		},
+		"alphabetic code": {
+			input: mustParseAmount(t, "1.5", "XBT"),
+			want:  []byte{0x00, 'X', 'B', 'T', 0x02, 0xac, 0x02},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data, err := tc.input.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !bytes.Equal(data, tc.want) {
+				t.Errorf("got: % x, want: % x", data, tc.want)
+			}
+
+			var got money.Amount
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !got.Equal(tc.input) {
+				t.Errorf("got: %s, want: %s", got, tc.input)
+			}
+		})
+	}
+}
+
+func TestAmountUnmarshalBinary_Errors(t *testing.T) {
+	type testCase struct {
+		input   []byte
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"empty":                  {input: nil, wantErr: money.ErrInvalidBinary},
+		"unknown numeric code":   {input: []byte{0x01, 0x00, 0x00}, wantErr: money.ErrInvalidBinary},
+		"truncated code":         {input: []byte{0x00, 'X', 'B'}, wantErr: money.ErrInvalidBinary},
+		"invalid code":           {input: []byte{0x00, 'x', 'b', 't', 0x00, 0x00}, wantErr: money.ErrInvalidCurrencyCode},
+		"missing quantity":       {input: []byte{0xc8, 0x06}, wantErr: money.ErrInvalidBinary},
+		"quantity too precise":   {input: []byte{0xc8, 0x06, 0x03, 0x02}, wantErr: money.ErrTooPrecise},
+		"trailing quantity byte": {input: []byte{0xc8, 0x06, 0x00, 0x02, 0x00}, wantErr: money.ErrInvalidBinary},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got money.Amount
+			if err := got.UnmarshalBinary(tc.input); !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAmountMarshalBinary_ZeroValue(t *testing.T) {
+	var zero money.Amount
+	if _, err := zero.MarshalBinary(); !errors.Is(err, money.ErrInvalidCurrencyCode) {
+		t.Errorf("got err: %v, want: %v", err, money.ErrInvalidCurrencyCode)
+	}
+}
+
+func FuzzDecimalBinary(f *testing.F) {
+	f.Add(int64(12345), uint8(2))
+	f.Add(int64(-1), uint8(18))
+	f.Add(int64(-1<<63), uint8(0))
+	f.Add(int64(1<<63-1), uint8(127))
+
+	f.Fuzz(func(t *testing.T, units int64, scale uint8) {
+		input := money.NewDecimal(units, scale%128)
+
+		data, err := input.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var got money.Decimal
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if got != input {
+			t.Errorf("got: %#v, want: %#v", got, input)
+		}
+
+		// the same value written in the reserved big form must be rejected, as it has a varint encoding.
+		magnitude := new(big.Int).Abs(big.NewInt(units)).Bytes()
+		sign := byte(0)
+		if units < 0 {
+			sign = 1
+		}
+
+		escaped := []byte{input.Scale() | 0x80, sign}
+		escaped = append(binary.AppendUvarint(escaped, uint64(len(magnitude))), magnitude...)
+
+		if err := got.UnmarshalBinary(escaped); !errors.Is(err, money.ErrInvalidBinary) {
+			t.Errorf("got err: %v for % x, want: %v", err, escaped, money.ErrInvalidBinary)
+		}
+	})
+}
+
+func FuzzAmountUnmarshalBinary(f *testing.F) {
+	f.Add([]byte{0xc8, 0x06, 0x02, 0xa4, 0x13})
+	f.Add([]byte{0x00, 'X', 'B', 'T', 0x02, 0xac, 0x02})
+	f.Add([]byte{0xce, 0x07, 0x82, 0x01, 0x02, 0x30, 0x39})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded money.Amount
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			return
+		}
+
+		encoded, err := decoded.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error re-encoding %s: %s", decoded, err.Error())
+		}
+
+		var got money.Amount
+		if err := got.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if !got.Equal(decoded) {
+			t.Errorf("got: %s, want: %s", got, decoded)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package money
+
+import "math/big"
+
+// cashIncrements contains the currencies whose cash payments settle in increments larger than the minor unit,
+// because the smallest coins are no longer in circulation.
+var cashIncrements = map[string]Decimal{
+	"AUD": {units: 5, precision: 2}, // Australian Dollar
+	"CAD": {units: 5, precision: 2}, // Canadian Dollar
+	"CHF": {units: 5, precision: 2}, // Swiss Franc
+	"CZK": {units: 1, precision: 0}, // Czech Koruna
+	"DKK": {units: 5, precision: 1}, // Danish Krone
+	"HUF": {units: 5, precision: 0}, // Hungarian Forint
+	"NOK": {units: 1, precision: 0}, // Norwegian Krone
+	"NZD": {units: 1, precision: 1}, // New Zealand Dollar
+	"SEK": {units: 1, precision: 0}, // Swedish Krona
+	"ZAR": {units: 1, precision: 1}, // South African Rand
+}
+
+// CashIncrement returns the smallest amount cash payments in the currency settle in,
+// which is the minor unit unless the smallest coins have been withdrawn. e.g. 0.05 for CHF, 0.01 for USD
+func (c Currency) CashIncrement() Decimal {
+	if increment, found := cashIncrements[c.code]; found {
+		return increment
+	}
+
+	return Decimal{units: 1, precision: c.precision}
+}
+
+// RoundCash rounds the Amount to the cash increment of its currency using the rounding mode,
+// e.g. 12.43 CHF rounded half up is 12.45 CHF.
+func (a Amount) RoundCash(mode RoundingMode) (Amount, error) {
+	increment := a.currency.CashIncrement()
+	if err := increment.updatePrecision(a.quantity.precision); err != nil {
+		return Amount{}, err
+	}
+
+	steps := divRound(big.NewInt(a.quantity.units), big.NewInt(increment.units), mode)
+	units := steps.Mul(steps, big.NewInt(increment.units))
+
+	if !units.IsInt64() {
+		return Amount{}, ErrOverflow
+	}
+
+	return Amount{
+		quantity: Decimal{units: units.Int64(), precision: a.quantity.precision},
+		currency: a.currency,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCurrencyCashIncrement(t *testing.T) {
+	type testCase struct {
+		code string
+		want Decimal
+	}
+
+	testCases := map[string]testCase{
+		"Swiss Franc": {
+			code: "CHF",
+			want: Decimal{units: 5, precision: 2},
+		},
+		"Swedish Krona": {
+			code: "SEK",
+			want: Decimal{units: 1, precision: 0},
+		},
+		"minor unit": {
+			code: "USD",
+			want: Decimal{units: 1, precision: 2},
+		},
+		"minor unit of a three decimal currency": {
+			code: "KWD",
+			want: Decimal{units: 1, precision: 3},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := mustCurrency(t, tc.code).CashIncrement()
+			if !decimalEqual(got, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountRoundCash(t *testing.T) {
+	chf := Currency{code: "CHF", precision: 2}
+	sek := Currency{code: "SEK", precision: 2}
+
+	type testCase struct {
+		in      Amount
+		mode    RoundingMode
+		want    Amount
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"round down to 5 centimes": {
+			in:   AmountFromMinorUnits(1242, chf),
+			mode: RoundHalfUp,
+			want: AmountFromMinorUnits(1240, chf),
+		},
+		"round up to 5 centimes": {
+			in:   AmountFromMinorUnits(1243, chf),
+			mode: RoundHalfUp,
+			want: AmountFromMinorUnits(1245, chf),
+		},
+		"tie rounded half even": {
+			in:   AmountFromMinorUnits(250, sek),
+			mode: RoundHalfEven,
+			want: AmountFromMinorUnits(200, sek),
+		},
+		"whole krona": {
+			in:   AmountFromMinorUnits(1950, sek),
+			mode: RoundHalfUp,
+			want: AmountFromMinorUnits(2000, sek),
+		},
+		"whole krona rounded down": {
+			in:   AmountFromMinorUnits(1999, sek),
+			mode: RoundDown,
+			want: AmountFromMinorUnits(1900, sek),
+		},
+		"negative amount": {
+			in:   AmountFromMinorUnits(-1243, chf),
+			mode: RoundHalfUp,
+			want: AmountFromMinorUnits(-1245, chf),
+		},
+		"no cash increment": {
+			in:   usd(1243),
+			mode: RoundHalfUp,
+			want: usd(1243),
+		},
+		"overflow": {
+			in:      AmountFromMinorUnits(math.MaxInt64, chf),
+			mode:    RoundUp,
+			wantErr: ErrOverflow,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.RoundCash(tc.mode)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %s, want: %s", &got, &tc.want)
+			}
+		})
+	}
+}
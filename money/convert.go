@@ -1,14 +1,20 @@
 package money
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
 
 // ExchangeRate represents a rate to convert from one currency to another.
 type ExchangeRate float64
 
 // Convert applies an exchange rate to convert an input amount to a target currency.
+// Rates for pegged currencies are derived from their anchor when the provider does not quote them.
 func Convert(amount Amount, to Currency, rates exchangeRates) (Amount, error) {
-	exchangeRate, err := rates.FetchExchangeRate(amount.currency, to)
-	
+	exchangeRate, err := fetchExchangeRate(rates, amount.currency, to)
 	if err != nil {
 		return Amount{}, fmt.Errorf("cannot get exchange rate: %w", err)
 	}
@@ -22,37 +28,75 @@ func Convert(amount Amount, to Currency, rates exchangeRates) (Amount, error) {
 }
 
 // applyExchangeRate returns a new Amount representing the input multiplied by the ExchangeRate.
-// The precision of the returned amount will match that of the target Currency.
+// The precision of the returned amount will match that of the target Currency, extra digits are truncated.
 // This function does not guarantee that the output amount is supported.
 func applyExchangeRate(a Amount, target Currency, rate ExchangeRate) (Amount, error) {
-	decRate, err := ParseDecimal(fmt.Sprintf("%g", rate))
+	decRate, err := exchangeRateDecimal(rate)
 	if err != nil {
-		return Amount{}, fmt.Errorf("could not convert exchange rate to decimal: %w", err)
+		return Amount{}, err
 	}
-	converted := multiply(a.quantity, decRate)
 
-	switch {
-	case converted.precision > target.precision:
-		converted.units /= pow10(converted.precision - target.precision)
-	case converted.precision < target.precision:
-		converted.units *= pow10(target.precision - converted.precision)
+	return convertQuantity(a.quantity, decRate, target, RoundDown)
+}
+
+// exchangeRateDecimal converts the ExchangeRate into the Decimal used in calculations,
+// using the shortest decimal that reads back as the same float, e.g. 0.0015244902.
+func exchangeRateDecimal(rate ExchangeRate) (Decimal, error) {
+	text := strconv.FormatFloat(float64(rate), 'f', -1, 64)
+	intPart, fracPart, _ := strings.Cut(text, ".")
+
+	units, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("could not convert exchange rate to decimal: %w: %s", ErrInvalidDecimal, text)
 	}
 
-	converted.precision = target.precision
+	if !units.IsInt64() || len(fracPart) > math.MaxUint8 {
+		return Decimal{}, fmt.Errorf("could not convert exchange rate to decimal: %w: %s", ErrOverflow, text)
+	}
+
+	return NewDecimal(units.Int64(), uint8(len(fracPart))), nil
+}
+
+// convertQuantity multiplies the quantity by the rate and rounds the product to the precision of the target Currency.
+// The product is calculated exactly, ErrOverflow is returned when the rounded result does not fit in an Amount.
+func convertQuantity(quantity, rate Decimal, target Currency, mode RoundingMode) (Amount, error) {
+	// units = quantity units * rate units * 10^target precision / 10^(quantity precision + rate precision)
+	numerator := new(big.Int).Mul(big.NewInt(quantity.units), big.NewInt(rate.units))
+	numerator.Mul(numerator, bigPow10(int(target.precision)))
+	denominator := new(big.Int).Mul(bigPow10(int(quantity.precision)), bigPow10(int(rate.precision)))
+
+	units := divRound(numerator, denominator, mode)
+	if !units.IsInt64() {
+		return Amount{}, ErrOverflow
+	}
 
 	return Amount{
 		currency: target,
-		quantity: converted,
+		quantity: Decimal{units: units.Int64(), precision: target.precision},
 	}, nil
 }
 
 // multiply multiplies two Decimal values together to produce a new Decimal value.
-func multiply(d1 Decimal, d2 Decimal) Decimal {
-	dec := Decimal{
-		units:     d1.units * d2.units,
-		precision: d1.precision + d2.precision,
+// ErrOverflow is returned when the exact product does not fit in a Decimal.
+func multiply(d1 Decimal, d2 Decimal) (Decimal, error) {
+	units := new(big.Int).Mul(big.NewInt(d1.units), big.NewInt(d2.units))
+	precision := int(d1.precision) + int(d2.precision)
+
+	// trailing zeroes are removed first, as they may be all that does not fit.
+	ten, quotient, remainder := big.NewInt(10), new(big.Int), new(big.Int)
+	for precision > 0 {
+		quotient.QuoRem(units, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+
+		units.Set(quotient)
+		precision--
+	}
+
+	if !units.IsInt64() || precision > math.MaxUint8 {
+		return Decimal{}, ErrOverflow
 	}
 
-	dec.simplify()
-	return dec
+	return Decimal{units: units.Int64(), precision: uint8(precision)}, nil
 }
@@ -1,6 +1,9 @@
 package money
 
 import (
+	"errors"
+	"math"
+	"math/big"
 	"reflect"
 	"testing"
 )
@@ -38,6 +41,18 @@ func TestApplyExchangeRate(t *testing.T) {
 				currency: Currency{code: "TST", precision: 2},
 			},
 		},
+		"rate below 0.0001": {
+			in: Amount{
+				quantity: Decimal{units: 10000000, precision: 0},
+				currency: Currency{code: "IRR", precision: 0},
+			},
+			rate:     ExchangeRate(0.0000238),
+			currency: Currency{code: "USD", precision: 2},
+			want: Amount{
+				quantity: Decimal{units: 23800, precision: 2},
+				currency: Currency{code: "USD", precision: 2},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -53,3 +68,173 @@ func TestApplyExchangeRate(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyExchangeRate_Overflow(t *testing.T) {
+	type testCase struct {
+		in       Amount
+		rate     ExchangeRate
+		currency Currency
+		wantErr  error
+	}
+
+	testCases := map[string]testCase{
+		"large amount times an 8 digit rate": {
+			in: Amount{
+				quantity: Decimal{units: 1_000_000_000_000, precision: 2},
+				currency: Currency{code: "IRR", precision: 2},
+			},
+			rate:     ExchangeRate(12345678),
+			currency: Currency{code: "TST", precision: 2},
+			wantErr:  ErrOverflow,
+		},
+		"rate too large for a decimal": {
+			in: Amount{
+				quantity: Decimal{units: 100, precision: 2},
+				currency: Currency{code: "USD", precision: 2},
+			},
+			rate:     ExchangeRate(1e20),
+			currency: Currency{code: "TST", precision: 2},
+			wantErr:  ErrOverflow,
+		},
+		"rate is not a number": {
+			in: Amount{
+				quantity: Decimal{units: 100, precision: 2},
+				currency: Currency{code: "USD", precision: 2},
+			},
+			rate:     ExchangeRate(math.NaN()),
+			currency: Currency{code: "TST", precision: 2},
+			wantErr:  ErrInvalidDecimal,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := applyExchangeRate(tc.in, tc.currency, tc.rate)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestMultiply(t *testing.T) {
+	type testCase struct {
+		d1      Decimal
+		d2      Decimal
+		want    Decimal
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"nominal": {
+			d1:   Decimal{units: 12300, precision: 2},
+			d2:   Decimal{units: 11111, precision: 4},
+			want: Decimal{units: 1366653, precision: 4},
+		},
+		"trailing zeroes are removed": {
+			d1:   Decimal{units: 5, precision: 1},
+			d2:   Decimal{units: 2, precision: 1},
+			want: Decimal{units: 1, precision: 1},
+		},
+		"fits after removing trailing zeroes": {
+			d1:   Decimal{units: math.MaxInt64/10 + 1, precision: 1},
+			d2:   Decimal{units: 10, precision: 1},
+			want: Decimal{units: math.MaxInt64/10 + 1, precision: 1},
+		},
+		"overflow": {
+			d1:      Decimal{units: 100_000_000_000_000, precision: 2},
+			d2:      Decimal{units: 12345678},
+			wantErr: ErrOverflow,
+		},
+		"negative overflow": {
+			d1:      Decimal{units: math.MinInt64},
+			d2:      Decimal{units: -1},
+			wantErr: ErrOverflow,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := multiply(tc.d1, tc.d2)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+// decimalRat returns the exact value of the Decimal.
+func decimalRat(d Decimal) *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(d.units), bigPow10(int(d.precision)))
+}
+
+func FuzzMultiply(f *testing.F) {
+	f.Add(int64(12300), uint8(2), int64(11111), uint8(4))
+	f.Add(int64(100_000_000_000_000), uint8(2), int64(12345678), uint8(0))
+	f.Add(int64(math.MinInt64), uint8(0), int64(-1), uint8(0))
+	f.Add(int64(5), uint8(200), int64(2), uint8(100))
+
+	f.Fuzz(func(t *testing.T, u1 int64, p1 uint8, u2 int64, p2 uint8) {
+		d1, d2 := Decimal{units: u1, precision: p1}, Decimal{units: u2, precision: p2}
+
+		got, err := multiply(d1, d2)
+		if errors.Is(err, ErrOverflow) {
+			return
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		want := new(big.Rat).Mul(decimalRat(d1), decimalRat(d2))
+		if decimalRat(got).Cmp(want) != 0 {
+			t.Fatalf("%s * %s: got %s, want %s", d1, d2, got, want.FloatString(int(p1)+int(p2)))
+		}
+	})
+}
+
+func FuzzApplyExchangeRate(f *testing.F) {
+	f.Add(int64(12300), uint8(2), 1.1111, uint8(2))
+	f.Add(int64(100_000_000_000_000), uint8(2), 12345678.0, uint8(2))
+	f.Add(int64(math.MaxInt64), uint8(0), 0.00001, uint8(3))
+	f.Add(int64(-12345), uint8(3), 0.5, uint8(0))
+
+	f.Fuzz(func(t *testing.T, units int64, precision uint8, rate float64, targetPrecision uint8) {
+		precision, targetPrecision = precision%19, targetPrecision%19
+		in := Amount{
+			quantity: Decimal{units: units, precision: precision},
+			currency: Currency{code: "SRC", precision: precision},
+		}
+		target := Currency{code: "TGT", precision: targetPrecision}
+
+		got, err := applyExchangeRate(in, target, ExchangeRate(rate))
+		if errors.Is(err, ErrOverflow) || errors.Is(err, ErrInvalidDecimal) {
+			return
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		decRate, err := exchangeRateDecimal(ExchangeRate(rate))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		// the result truncates the exact product, so it is within one minor unit of it and not further from zero.
+		exact := new(big.Rat).Mul(decimalRat(in.quantity), decimalRat(decRate))
+		difference := new(big.Rat).Sub(exact, decimalRat(got.quantity))
+		difference.Mul(difference, new(big.Rat).SetInt(bigPow10(int(targetPrecision))))
+		if exact.Sign() < 0 {
+			difference.Neg(difference)
+		}
+
+		if difference.Sign() < 0 || difference.Cmp(big.NewRat(1, 1)) >= 0 {
+			t.Fatalf("%s at %v: got %s, want the truncation of %s", in, rate, got, exact.FloatString(int(targetPrecision)+4))
+		}
+	})
+}
@@ -1,75 +1,79 @@
 package money_test
 
-// import (
-// 	"reflect"
-// 	"testing"
+import (
+	"errors"
+	"testing"
 
-// 	"github.com/th3oth3rjak3/MoneyConverter/money"
-// )
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+	"github.com/th3oth3rjak3/MoneyConverter/money/moneytest"
+)
 
-// // mustParseCurrency is a helper method that ensures valid currency is provided
-// // to the Convert tests.
-// func mustParseCurrency(t *testing.T, code string) money.Currency {
-// 	t.Helper()
+func TestConvert(t *testing.T) {
+	usd, eur := moneytest.MustCurrency(t, "USD"), moneytest.MustCurrency(t, "EUR")
 
-// 	currency, err := money.ParseCurrency(code)
-// 	if err != nil {
-// 		t.Fatalf("cannot parse currency code: %s", code)
-// 	}
+	type testCase struct {
+		amount  money.Amount
+		to      money.Currency
+		want    money.Amount
+		wantErr error
+	}
 
-// 	return currency
-// }
+	testCases := map[string]testCase{
+		"11.22 USD to EUR": {
+			amount: moneytest.MustAmount(t, "11.22", "USD"),
+			to:     eur,
+			// 10.098 EUR is truncated.
+			want: moneytest.MustAmount(t, "10.09", "EUR"),
+		},
+		"to a currency with fewer decimal places": {
+			amount: moneytest.MustAmount(t, "10", "EUR"),
+			to:     moneytest.MustCurrency(t, "IRR"),
+			want:   moneytest.MustAmount(t, "465500", "IRR"),
+		},
+		"same currency": {
+			amount: moneytest.MustAmount(t, "11.22", "USD"),
+			to:     usd,
+			want:   moneytest.MustAmount(t, "11.22", "USD"),
+		},
+		"rate not found": {
+			amount:  moneytest.MustAmount(t, "11.22", "EUR"),
+			to:      usd,
+			wantErr: moneytest.ErrRateNotFound,
+		},
+	}
 
-// // mustParseAmount is a helper method that ensures valid amounts can be created
-// // for testing Convert.
-// func mustParseAmount(t *testing.T, value string, code string) money.Amount {
-// 	t.Helper()
+	rates := moneytest.NewFakeProvider().
+		SetRate(usd, eur, 0.9).
+		SetRate(eur, moneytest.MustCurrency(t, "IRR"), 46550)
 
-// 	decimal, err := money.ParseDecimal(value)
-// 	if err != nil {
-// 		t.Fatalf("invalid number: %s", value)
-// 	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := money.Convert(tc.amount, tc.to, rates)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
 
-// 	currency, err := money.ParseCurrency(code)
-// 	if err != nil {
-// 		t.Fatalf("invalid currency code: %s", code)
-// 	}
+			if !got.Equal(tc.want) {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+		})
+	}
+}
 
-// 	amount, err := money.NewAmount(decimal, currency)
-// 	if err != nil {
-// 		t.Fatalf("cannot create new amount with value %#v and currency code %s", decimal, code)
-// 	}
+func TestConvert_ProviderError(t *testing.T) {
+	usd, eur := moneytest.MustCurrency(t, "USD"), moneytest.MustCurrency(t, "EUR")
+	errUnavailable := errors.New("provider unavailable")
 
-// 	return amount
-// }
+	rates := moneytest.NewFakeProvider().
+		SetRate(usd, eur, 0.9).
+		SetError(usd, eur, errUnavailable)
 
-// func TestConvert(t *testing.T) {
-// 	type testCase struct {
-// 		amount   money.Amount
-// 		to       money.Currency
-// 		validate func(t *testing.T, got money.Amount, err error)
-// 	}
+	_, err := money.Convert(moneytest.MustAmount(t, "1", "USD"), eur, rates)
+	if !errors.Is(err, errUnavailable) {
+		t.Errorf("got err: %v, want: %v", err, errUnavailable)
+	}
 
-// 	testCases := map[string]testCase{
-// 		"11.22 USD to EUR": {
-// 			amount: mustParseAmount(t, "11.22", "USD"),
-// 			to:     mustParseCurrency(t, "EUR"),
-// 			validate: func(t *testing.T, got money.Amount, err error) {
-// 				if err != nil {
-// 					t.Errorf("unexpected error: %v", err)
-// 				}
-// 				expected := money.Amount{}
-// 				if !reflect.DeepEqual(got, expected) {
-// 					t.Errorf("got %v, want %v", got, expected)
-// 				}
-// 			},
-// 		},
-// 	}
-
-// 	for name, tc := range testCases {
-// 		t.Run(name, func(t *testing.T) {
-// 			got, err := money.Convert(tc.amount, tc.to)
-// 			tc.validate(t, got, err)
-// 		})
-// 	}
-// }
+	if calls := rates.Calls(usd, eur); calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+}
@@ -39,6 +39,11 @@ func (c Currency) ISOCode() string {
 	return c.code
 }
 
+// Precision returns the number of decimal places of the currency's minor unit, e.g. 2 for cents.
+func (c Currency) Precision() uint8 {
+	return c.precision
+}
+
 // validateCurrencyCode checks if the currency code is a valid ISO 4217 code.
 // It currently uses a naive approach and only checks the length and character range.
 func validateCurrencyCode(code string) error {
@@ -2,6 +2,8 @@ package money
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -13,6 +15,8 @@ const (
 	ErrTooLarge = Error("quantity over 10^12 is too large")
 	// ErrPrecisionDecrease is returned when an attempt is made to decrease decimal precision which would result in errors.
 	ErrPrecisionDecrease = Error("cannot decrease the precision of a decimal")
+	// ErrOverflow is returned when the result of a calculation does not fit in a Decimal.
+	ErrOverflow = Error("decimal overflow")
 )
 
 // Decimal represents a decimal number which can store a floating point value.
@@ -24,20 +28,44 @@ type Decimal struct {
 	precision uint8
 }
 
+// NewDecimal creates a Decimal from its units and scale, representing units * 10^-scale.
+// Example: NewDecimal(12345, 2) is 123.45
+// Trailing zeroes are removed, so NewDecimal(1230, 2) reports 123 units and a scale of 1.
+func NewDecimal(units int64, scale uint8) Decimal {
+	decimal := Decimal{units: units, precision: scale}
+	decimal.simplify()
+	return decimal
+}
+
 // ParseDecimal parses a string representation of a decimal number and returns a Decimal.
 // The input string should be in the format "123.45" where the decimal point is optional.
-// It assumes no more than a single decimal point.
+// It may start with a sign and either side of the decimal point may be empty, e.g. "-.5" or "+1.",
+// but not both.
 func ParseDecimal(value string) (Decimal, error) {
-	intPart, fracPart, _ := strings.Cut(value, ".")
+	sign, digits := "", value
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		sign, digits = digits[:1], digits[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
 
 	// maxDecimal is the number of digits in one trillion (10^12).
 	const maxDecimal = 12
 
-	if len(intPart) > maxDecimal {
+	if len(strings.TrimLeft(intPart, "0")) > maxDecimal {
 		return Decimal{}, ErrTooLarge
 	}
 
-	units, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	// trailing zeroes do not change the value, so they cannot make it overflow.
+	fracPart = strings.TrimRight(fracPart, "0")
+	if len(fracPart) > math.MaxUint8 {
+		return Decimal{}, fmt.Errorf("%w: %q has too many decimal places", ErrOverflow, value)
+	}
+
+	units, err := strconv.ParseInt(sign+intPart+fracPart, 10, 64)
 	if err != nil {
 		return Decimal{}, fmt.Errorf("%w: %s", ErrInvalidDecimal, err.Error())
 	}
@@ -48,17 +76,38 @@ func ParseDecimal(value string) (Decimal, error) {
 	return *decimal, nil
 }
 
+// Units returns the integer representation of the Decimal, see Scale.
+func (d Decimal) Units() int64 {
+	return d.units
+}
+
+// Scale returns the number of decimal places, the value of the Decimal is Units() * 10^-Scale().
+func (d Decimal) Scale() uint8 {
+	return d.precision
+}
+
 // String implements the Stringer interface.
-func (d *Decimal) String() string {
+func (d Decimal) String() string {
 	if d.precision == 0 {
 		return fmt.Sprintf("%d", d.units)
 	}
 
-	divisor := pow10(d.precision)
-	frac := d.units % divisor
-	whole := d.units / divisor
-	decimalFormat := "%d.%0" + strconv.Itoa(int(d.precision)) + "d"
-	return fmt.Sprintf(decimalFormat, whole, frac)
+	sign := ""
+	// the magnitude is unsigned so that the smallest int64 can be negated.
+	magnitude := uint64(d.units)
+	if d.units < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+
+	// the digits are split as text because 10^precision may not fit in an int64.
+	digits := strconv.FormatUint(magnitude, 10)
+	if len(digits) <= int(d.precision) {
+		digits = strings.Repeat("0", int(d.precision)-len(digits)+1) + digits
+	}
+
+	split := len(digits) - int(d.precision)
+	return sign + digits[:split] + "." + digits[split:]
 }
 
 // simplify removes trailing zeroes when it would not affect the value.
@@ -70,7 +119,7 @@ func (d *Decimal) simplify() {
 }
 
 // pow10 returns the representation of 10^power (e.g. 10^0 = 1, 10^1 = 10)
-// optimized for powers of 9 or less.
+// optimized for powers of 9 or less. The power must be at most maxPow10, see mulPow10.
 func pow10(power uint8) int64 {
 	values := map[uint8]int64{
 		0: 1,
@@ -97,7 +146,34 @@ func pow10(power uint8) int64 {
 	return pow
 }
 
+// maxPow10 is the largest power of 10 that fits in an int64.
+const maxPow10 = 18
+
+// mulPow10 returns units * 10^power, or ErrOverflow when the result does not fit in an int64.
+func mulPow10(units int64, power uint8) (int64, error) {
+	if units == 0 || power == 0 {
+		return units, nil
+	}
+
+	if power > maxPow10 {
+		return 0, ErrOverflow
+	}
+
+	factor := pow10(power)
+	if units > math.MaxInt64/factor || units < math.MinInt64/factor {
+		return 0, ErrOverflow
+	}
+
+	return units * factor, nil
+}
+
+// bigPow10 returns 10^power as a big.Int for calculations that may not fit in an int64.
+func bigPow10(power int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(power)), nil)
+}
+
 // updatePrecision adds additional precision to the Decimal, updating the precision and units correctly.
+// ErrOverflow is returned, leaving the Decimal unchanged, when the units no longer fit in an int64.
 func (d *Decimal) updatePrecision(precision uint8) error {
 	if precision < d.precision {
 		return ErrPrecisionDecrease
@@ -109,8 +185,69 @@ func (d *Decimal) updatePrecision(precision uint8) error {
 		return nil
 	}
 
-	d.units *= pow10(increase)
+	units, err := mulPow10(d.units, increase)
+	if err != nil {
+		return err
+	}
+
+	d.units = units
 	d.precision = precision
 
 	return nil
 }
+
+// add adds two Decimal values together, aligning them to the greater precision.
+func add(d1, d2 Decimal) (Decimal, error) {
+	if err := align(&d1, &d2); err != nil {
+		return Decimal{}, err
+	}
+
+	sum := d1.units + d2.units
+	if (d2.units > 0 && sum < d1.units) || (d2.units < 0 && sum > d1.units) {
+		return Decimal{}, ErrOverflow
+	}
+
+	return Decimal{units: sum, precision: d1.precision}, nil
+}
+
+// sub subtracts d2 from d1, aligning them to the greater precision.
+func sub(d1, d2 Decimal) (Decimal, error) {
+	if err := align(&d1, &d2); err != nil {
+		return Decimal{}, err
+	}
+
+	difference := d1.units - d2.units
+	if (d2.units > 0 && difference > d1.units) || (d2.units < 0 && difference < d1.units) {
+		return Decimal{}, ErrOverflow
+	}
+
+	return Decimal{units: difference, precision: d1.precision}, nil
+}
+
+// compare returns -1, 0 or +1 depending on whether d1 is less than, equal to or greater than d2.
+func compare(d1, d2 Decimal) int {
+	if d1.precision != d2.precision {
+		// rescaling can overflow, so compare exactly instead.
+		x := new(big.Int).Mul(big.NewInt(d1.units), bigPow10(int(d2.precision)))
+		y := new(big.Int).Mul(big.NewInt(d2.units), bigPow10(int(d1.precision)))
+		return x.Cmp(y)
+	}
+
+	switch {
+	case d1.units < d2.units:
+		return -1
+	case d1.units > d2.units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// align updates the precision of the less precise Decimal to match the other one.
+func align(d1, d2 *Decimal) error {
+	if d1.precision < d2.precision {
+		return d1.updatePrecision(d2.precision)
+	}
+
+	return d2.updatePrecision(d1.precision)
+}
@@ -2,7 +2,10 @@ package money
 
 import (
 	"errors"
+	"math"
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -74,6 +77,71 @@ func TestParseDecimal(t *testing.T) {
 			want:    Decimal{units: 12345000, precision: 0},
 			wantErr: nil,
 		},
+		"two decimal points": {
+			input:   "1.2.3",
+			want:    Decimal{},
+			wantErr: ErrInvalidDecimal,
+		},
+		"period only": {
+			input:   ".",
+			want:    Decimal{},
+			wantErr: ErrInvalidDecimal,
+		},
+		"sign only": {
+			input:   "-",
+			want:    Decimal{},
+			wantErr: ErrInvalidDecimal,
+		},
+		"sign in the decimal part": {
+			input:   ".+5",
+			want:    Decimal{},
+			wantErr: ErrInvalidDecimal,
+		},
+		"sign after the digits": {
+			input:   "5-",
+			want:    Decimal{},
+			wantErr: ErrInvalidDecimal,
+		},
+		"spaces": {
+			input:   " 1.5",
+			want:    Decimal{},
+			wantErr: ErrInvalidDecimal,
+		},
+		"decimal part only": {
+			input:   ".5",
+			want:    Decimal{units: 5, precision: 1},
+			wantErr: nil,
+		},
+		"negative decimal part only": {
+			input:   "-.5",
+			want:    Decimal{units: -5, precision: 1},
+			wantErr: nil,
+		},
+		"leading plus sign": {
+			input:   "+1",
+			want:    Decimal{units: 1, precision: 0},
+			wantErr: nil,
+		},
+		"negative with 12 digits": {
+			input:   "-123456789012.34",
+			want:    Decimal{units: -12345678901234, precision: 2},
+			wantErr: nil,
+		},
+		"leading zeroes are not too large": {
+			input:   "0000000000001",
+			want:    Decimal{units: 1, precision: 0},
+			wantErr: nil,
+		},
+		"trailing zeroes beyond int64": {
+			input:   "1.50000000000000000000",
+			want:    Decimal{units: 15, precision: 1},
+			wantErr: nil,
+		},
+		"too many decimal places": {
+			input:   "0." + strings.Repeat("0", 255) + "1",
+			want:    Decimal{},
+			wantErr: ErrOverflow,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -134,6 +202,24 @@ func TestUpdatePrecision(t *testing.T) {
 			want:         Decimal{units: 123, precision: 1},
 			wantErr:      ErrPrecisionDecrease,
 		},
+		"overflow should not change original": {
+			input:        &Decimal{units: math.MaxInt64 / 10, precision: 0},
+			newPrecision: 2,
+			want:         Decimal{units: math.MaxInt64 / 10, precision: 0},
+			wantErr:      ErrOverflow,
+		},
+		"negative overflow": {
+			input:        &Decimal{units: -1, precision: 0},
+			newPrecision: 19,
+			want:         Decimal{units: -1, precision: 0},
+			wantErr:      ErrOverflow,
+		},
+		"zero never overflows": {
+			input:        &Decimal{units: 0, precision: 0},
+			newPrecision: 30,
+			want:         Decimal{units: 0, precision: 30},
+			wantErr:      nil,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -219,3 +305,156 @@ func TestPow10(t *testing.T) {
 		})
 	}
 }
+
+func TestDecimalString_LargePrecision(t *testing.T) {
+	testCases := map[string]struct {
+		input Decimal
+		want  string
+	}{
+		"precision beyond int64":  {input: Decimal{units: 15, precision: 20}, want: "0.00000000000000000015"},
+		"negative":                {input: Decimal{units: -15, precision: 20}, want: "-0.00000000000000000015"},
+		"smallest int64":          {input: Decimal{units: math.MinInt64, precision: 2}, want: "-92233720368547758.08"},
+		"all digits are fraction": {input: Decimal{units: 123, precision: 3}, want: "0.123"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.input.String(); got != tc.want {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func FuzzUpdatePrecision(f *testing.F) {
+	f.Add(int64(123), uint8(0), uint8(2))
+	f.Add(int64(math.MaxInt64/10), uint8(0), uint8(2))
+	f.Add(int64(-1), uint8(0), uint8(19))
+
+	f.Fuzz(func(t *testing.T, units int64, precision, newPrecision uint8) {
+		d := Decimal{units: units, precision: precision}
+		if err := d.updatePrecision(newPrecision); err != nil {
+			if d.units != units || d.precision != precision {
+				t.Fatalf("failed update changed %d@%d to %#v", units, precision, d)
+			}
+
+			return
+		}
+
+		// the units must be exactly the original scaled up, without wrapping around.
+		want := new(big.Int).Mul(big.NewInt(units), bigPow10(int(newPrecision-precision)))
+		if want.Cmp(big.NewInt(d.units)) != 0 {
+			t.Fatalf("%d@%d to precision %d: got %d, want %s", units, precision, newPrecision, d.units, want)
+		}
+	})
+}
+
+func FuzzParseDecimal(f *testing.F) {
+	for _, seed := range []string{"", ".", "1.2.3", ".5", "-.5", "+1", "123.450", "-0", "1e5", "0x1F", "999999999999.999999"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		got, err := ParseDecimal(input)
+		if err != nil {
+			return
+		}
+
+		// the value must be exactly the one written, which big.Rat parses independently.
+		want, ok := new(big.Rat).SetString(input)
+		if !ok || strings.ContainsAny(input, "eEx/_") {
+			t.Fatalf("ParseDecimal(%q) accepted an invalid decimal as %s", input, got)
+		}
+
+		if decimalRat(got).Cmp(want) != 0 {
+			t.Fatalf("ParseDecimal(%q) got %s, want %s", input, got, want.FloatString(int(got.precision)))
+		}
+
+		if got.precision > 0 && got.units%10 == 0 {
+			t.Fatalf("ParseDecimal(%q) got %#v, which is not simplified", input, got)
+		}
+	})
+}
+
+func FuzzDecimalString(f *testing.F) {
+	f.Add(int64(12345), uint8(2))
+	f.Add(int64(-5), uint8(1))
+	f.Add(int64(math.MinInt64), uint8(0))
+	f.Add(int64(15), uint8(20))
+
+	f.Fuzz(func(t *testing.T, units int64, precision uint8) {
+		d := NewDecimal(units, precision)
+
+		got, err := ParseDecimal(d.String())
+		if errors.Is(err, ErrTooLarge) {
+			// the text of large decimals has more integer digits than ParseDecimal accepts.
+			return
+		}
+
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q) returned error: %s", d.String(), err.Error())
+		}
+
+		if !decimalEqual(got, d) {
+			t.Fatalf("ParseDecimal(%q) got %#v, want %#v", d.String(), got, d)
+		}
+	})
+}
+
+func FuzzDecimalArithmetic(f *testing.F) {
+	f.Add(int64(12345), uint8(2), int64(-5), uint8(1), int64(7), uint8(0))
+	f.Add(int64(math.MaxInt64), uint8(0), int64(1), uint8(0), int64(-1), uint8(0))
+	f.Add(int64(1), uint8(18), int64(1), uint8(0), int64(3), uint8(5))
+
+	f.Fuzz(func(t *testing.T, u1 int64, p1 uint8, u2 int64, p2 uint8, u3 int64, p3 uint8) {
+		a := Decimal{units: u1, precision: p1 % 30}
+		b := Decimal{units: u2, precision: p2 % 30}
+		c := Decimal{units: u3, precision: p3 % 30}
+
+		// addition is commutative, and both orders overflow alike.
+		ab, errAB := add(a, b)
+		ba, errBA := add(b, a)
+		if (errAB == nil) != (errBA == nil) || errAB == nil && compare(ab, ba) != 0 {
+			t.Fatalf("%s + %s is not commutative: %s (%v), %s (%v)", a, b, ab, errAB, ba, errBA)
+		}
+
+		// multiplication is commutative.
+		product, errProduct := multiply(a, b)
+		reversed, errReversed := multiply(b, a)
+		if (errProduct == nil) != (errReversed == nil) || errProduct == nil && !decimalEqual(product, reversed) {
+			t.Fatalf("%s * %s is not commutative: %s (%v), %s (%v)", a, b, product, errProduct, reversed, errReversed)
+		}
+
+		// addition is associative whenever neither grouping overflows.
+		if errAB == nil {
+			left, errLeft := add(ab, c)
+			bc, errBC := add(b, c)
+			if errLeft == nil && errBC == nil {
+				right, errRight := add(a, bc)
+				if errRight == nil && compare(left, right) != 0 {
+					t.Fatalf("(%s + %s) + %s = %s, but %s + (%s + %s) = %s", a, b, c, left, a, b, c, right)
+				}
+			}
+
+			// subtracting b undoes adding it.
+			if b.units != math.MinInt64 {
+				back, err := add(ab, Decimal{units: -b.units, precision: b.precision})
+				if err != nil {
+					t.Fatalf("(%s + %s) - %s returned error: %s", a, b, b, err.Error())
+				}
+
+				if compare(back, a) != 0 {
+					t.Fatalf("(%s + %s) - %s = %s, want %s", a, b, b, back, a)
+				}
+			}
+		}
+
+		// every result is exact, without silent wraparound.
+		if errAB == nil {
+			want := new(big.Rat).Add(decimalRat(a), decimalRat(b))
+			if decimalRat(ab).Cmp(want) != 0 {
+				t.Fatalf("%s + %s: got %s, want %s", a, b, ab, want.FloatString(int(ab.precision)))
+			}
+		}
+	})
+}
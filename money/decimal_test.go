@@ -41,6 +41,18 @@ func TestDecimalString(t *testing.T) {
 			input: mustParseDecimal(t, "1.10"),
 			want:  "1.1",
 		},
+		"negative with no precision": {
+			input: mustParseDecimal(t, "-12"),
+			want:  "-12",
+		},
+		"negative with decimal places": {
+			input: mustParseDecimal(t, "-1.05"),
+			want:  "-1.05",
+		},
+		"negative fraction only": {
+			input: mustParseDecimal(t, "-0.5"),
+			want:  "-0.5",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -52,3 +64,59 @@ func TestDecimalString(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDecimal(t *testing.T) {
+	type testCase struct {
+		units     int64
+		scale     uint8
+		wantUnits int64
+		wantScale uint8
+		wantStr   string
+	}
+
+	testCases := map[string]testCase{
+		"integer": {
+			units:     123,
+			scale:     0,
+			wantUnits: 123,
+			wantScale: 0,
+			wantStr:   "123",
+		},
+		"with decimal places": {
+			units:     12345,
+			scale:     2,
+			wantUnits: 12345,
+			wantScale: 2,
+			wantStr:   "123.45",
+		},
+		"trailing zeroes are removed": {
+			units:     1230,
+			scale:     2,
+			wantUnits: 123,
+			wantScale: 1,
+			wantStr:   "12.3",
+		},
+		"negative": {
+			units:     -5,
+			scale:     3,
+			wantUnits: -5,
+			wantScale: 3,
+			wantStr:   "-0.005",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := money.NewDecimal(tc.units, tc.scale)
+			if got.Units() != tc.wantUnits || got.Scale() != tc.wantScale {
+				t.Errorf("got: %d, %d, want: %d, %d", got.Units(), got.Scale(), tc.wantUnits, tc.wantScale)
+			}
+			if got.String() != tc.wantStr {
+				t.Errorf("got: %s, want: %s", got.String(), tc.wantStr)
+			}
+			if parsed := mustParseDecimal(t, tc.wantStr); parsed != got {
+				t.Errorf("got: %#v, want the parsed value: %#v", got, parsed)
+			}
+		})
+	}
+}
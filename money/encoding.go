@@ -0,0 +1,146 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface, e.g. "123.45".
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, reading the format of ParseDecimal.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	decimal, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = decimal
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, e.g. "USD".
+func (c Currency) MarshalText() ([]byte, error) {
+	return []byte(c.code), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, reading an ISO code as ParseCurrency does.
+func (c *Currency) UnmarshalText(text []byte) error {
+	currency, err := ParseCurrency(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = currency
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, e.g. "12.34 USD".
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, reading the format of MarshalText.
+func (a *Amount) UnmarshalText(text []byte) error {
+	quantity, code, found := strings.Cut(string(text), " ")
+	if !found {
+		return fmt.Errorf("%w: %q", ErrInvalidAmount, text)
+	}
+
+	amount, err := decodeAmount(quantity, code)
+	if err != nil {
+		return err
+	}
+
+	*a = amount
+	return nil
+}
+
+// amountJSON is the JSON representation of an Amount. The quantity is a string so that it is not read as a float.
+type amountJSON struct {
+	Amount   *string `json:"amount"`
+	Currency *string `json:"currency"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, e.g. {"amount":"12.34","currency":"USD"}.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	quantity, code := a.quantity.String(), a.currency.code
+	return json.Marshal(amountJSON{Amount: &quantity, Currency: &code})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, reading the format of MarshalJSON.
+// Both fields are required, the amount must be a string and other fields are rejected.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	// by convention unmarshalling null is a no-op.
+	if string(data) == "null" {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var value amountJSON
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidAmount, err)
+	}
+
+	if value.Amount == nil || value.Currency == nil {
+		return fmt.Errorf("%w: amount and currency are required", ErrInvalidAmount)
+	}
+
+	amount, err := decodeAmount(*value.Amount, *value.Currency)
+	if err != nil {
+		return err
+	}
+
+	*a = amount
+	return nil
+}
+
+// amountXML is the XML representation of an Amount, e.g. <price currency="USD">12.34</price>.
+type amountXML struct {
+	Currency string `xml:"currency,attr"`
+	Amount   string `xml:",chardata"`
+}
+
+// MarshalXML implements the xml.Marshaler interface, writing the quantity as the element's text
+// and the currency as its attribute, e.g. <price currency="USD">12.34</price>.
+func (a Amount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(amountXML{Currency: a.currency.code, Amount: a.quantity.String()}, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, reading the format of MarshalXML.
+func (a *Amount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value amountXML
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	amount, err := decodeAmount(value.Amount, value.Currency)
+	if err != nil {
+		return err
+	}
+
+	*a = amount
+	return nil
+}
+
+// decodeAmount creates an Amount from its encoded quantity and ISO code.
+func decodeAmount(quantity, code string) (Amount, error) {
+	decimal, err := ParseDecimal(quantity)
+	if err != nil {
+		return Amount{}, fmt.Errorf("%w: %w", ErrInvalidAmount, err)
+	}
+
+	currency, err := ParseCurrency(code)
+	if err != nil {
+		return Amount{}, fmt.Errorf("%w: %w", ErrInvalidAmount, err)
+	}
+
+	return NewAmount(decimal, currency)
+}
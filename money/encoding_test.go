@@ -0,0 +1,185 @@
+package money_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+func TestAmountJSON(t *testing.T) {
+	type payment struct {
+		Price money.Amount   `json:"price"`
+		Rate  money.Decimal  `json:"rate"`
+		To    money.Currency `json:"to"`
+	}
+
+	input := payment{
+		Price: mustParseAmount(t, "12.3", "USD"),
+		Rate:  mustParseDecimal(t, "0.91234"),
+		To:    mustParseCurrency(t, "EUR"),
+	}
+	want := `{"price":{"amount":"12.30","currency":"USD"},"rate":"0.91234","to":"EUR"}`
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if string(data) != want {
+		t.Errorf("got: %s, want: %s", data, want)
+	}
+
+	var got payment
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !got.Price.Equal(input.Price) || got.Rate != input.Rate || got.To != input.To {
+		t.Errorf("got: %v, want: %v", got, input)
+	}
+}
+
+func TestAmountUnmarshalJSON_Errors(t *testing.T) {
+	type testCase struct {
+		input   string
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"missing currency": {
+			input:   `{"amount":"12.34"}`,
+			wantErr: money.ErrInvalidAmount,
+		},
+		"missing amount": {
+			input:   `{"currency":"USD"}`,
+			wantErr: money.ErrInvalidAmount,
+		},
+		"number amount": {
+			input:   `{"amount":12.34,"currency":"USD"}`,
+			wantErr: money.ErrInvalidAmount,
+		},
+		"unknown field": {
+			input:   `{"amount":"12.34","currency":"USD","rate":"1"}`,
+			wantErr: money.ErrInvalidAmount,
+		},
+		"invalid amount": {
+			input:   `{"amount":"12,34","currency":"USD"}`,
+			wantErr: money.ErrInvalidDecimal,
+		},
+		"invalid currency": {
+			input:   `{"amount":"12.34","currency":"usd"}`,
+			wantErr: money.ErrInvalidCurrencyCode,
+		},
+		"too precise": {
+			input:   `{"amount":"12.345","currency":"USD"}`,
+			wantErr: money.ErrTooPrecise,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got money.Amount
+			err := json.Unmarshal([]byte(tc.input), &got)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecimalUnmarshalJSON_Number(t *testing.T) {
+	var got money.Decimal
+	if err := json.Unmarshal([]byte(`12.34`), &got); err == nil {
+		t.Errorf("got: %s, want an error for a JSON number", got)
+	}
+}
+
+func TestAmountText(t *testing.T) {
+	input := mustParseAmount(t, "-0.5", "KWD")
+	want := "-0.500 KWD"
+
+	data, err := input.MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if string(data) != want {
+		t.Errorf("got: %s, want: %s", data, want)
+	}
+
+	var got money.Amount
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !got.Equal(input) {
+		t.Errorf("got: %s, want: %s", got, input)
+	}
+}
+
+func TestAmountUnmarshalText_Errors(t *testing.T) {
+	type testCase struct {
+		input   string
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"missing currency": {input: "12.34", wantErr: money.ErrInvalidAmount},
+		"invalid currency": {input: "12.34 US", wantErr: money.ErrInvalidCurrencyCode},
+		"extra space":      {input: "12.34  USD", wantErr: money.ErrInvalidCurrencyCode},
+		"currency first":   {input: "USD 12.34", wantErr: money.ErrInvalidDecimal},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got money.Amount
+			err := got.UnmarshalText([]byte(tc.input))
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAmountXML(t *testing.T) {
+	type invoice struct {
+		XMLName xml.Name     `xml:"invoice"`
+		Total   money.Amount `xml:"total"`
+		Tax     money.Amount `xml:"tax"`
+	}
+
+	input := invoice{
+		Total: mustParseAmount(t, "1234.5", "EUR"),
+		Tax:   mustParseAmount(t, "12", "JPY"),
+	}
+	want := `<invoice><total currency="EUR">1234.50</total><tax currency="JPY">12.00</tax></invoice>`
+
+	data, err := xml.Marshal(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if string(data) != want {
+		t.Errorf("got: %s, want: %s", data, want)
+	}
+
+	var got invoice
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !got.Total.Equal(input.Total) || !got.Tax.Equal(input.Tax) {
+		t.Errorf("got: %v and %v, want: %v and %v", got.Total, got.Tax, input.Total, input.Tax)
+	}
+}
+
+func TestAmountUnmarshalXML_MissingCurrency(t *testing.T) {
+	var got money.Amount
+	err := xml.Unmarshal([]byte(`<total>12.34</total>`), &got)
+	if !errors.Is(err, money.ErrInvalidCurrencyCode) {
+		t.Errorf("got err: %v, want: %v", err, money.ErrInvalidCurrencyCode)
+	}
+}
@@ -4,3 +4,129 @@ package money
 type exchangeRates interface {
 	FetchExchangeRate(source, target Currency) (ExchangeRate, error)
 }
+
+// rateTableProvider is implemented by providers that can return all of their rates in a single call.
+type rateTableProvider interface {
+	FetchRateTable() (RateTable, error)
+}
+
+// quoteProvider is implemented by providers that can describe where their rates come from.
+type quoteProvider interface {
+	FetchQuote(source, target Currency) (RateQuote, error)
+}
+
+// batched returns a provider that answers every lookup from a single fetch when the provider supports it,
+// or the provider itself otherwise.
+func batched(rates exchangeRates) (exchangeRates, error) {
+	tables, ok := rates.(rateTableProvider)
+	if !ok {
+		return rates, nil
+	}
+
+	table, err := tables.FetchRateTable()
+	if err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+// fetchExchangeRate gets the exchange rate from the provider, falling back to the official pegs
+// of the source and target currencies when the provider does not quote the pair directly.
+// The error of the direct lookup is returned when no peg applies or the anchor rate is also unavailable.
+func fetchExchangeRate(rates exchangeRates, source, target Currency) (ExchangeRate, error) {
+	rate, err := rates.FetchExchangeRate(source, target)
+	if err == nil {
+		return rate, nil
+	}
+
+	pegged, pegErr := peggedQuote(rates, source, target)
+	if pegErr != nil {
+		return 0, err
+	}
+
+	return pegged.Rate, nil
+}
+
+// fetchQuote gets the exchange rate and its origin from the provider, falling back to the official pegs
+// like fetchExchangeRate.
+func fetchQuote(rates exchangeRates, source, target Currency) (RateQuote, error) {
+	quote, err := directQuote(rates, source, target)
+	if err == nil {
+		return quote, nil
+	}
+
+	pegged, pegErr := peggedQuote(rates, source, target)
+	if pegErr != nil {
+		return RateQuote{}, err
+	}
+
+	return pegged, nil
+}
+
+// directQuote asks the provider for the exchange rate, describing its origin when the provider can.
+func directQuote(rates exchangeRates, source, target Currency) (RateQuote, error) {
+	if quotes, ok := rates.(quoteProvider); ok {
+		quote, err := quotes.FetchQuote(source, target)
+		if err != nil {
+			return RateQuote{}, err
+		}
+
+		if len(quote.Path) == 0 {
+			quote.Path = []Currency{source, target}
+		}
+
+		return quote, nil
+	}
+
+	rate, err := rates.FetchExchangeRate(source, target)
+	if err != nil {
+		return RateQuote{}, err
+	}
+
+	return RateQuote{Rate: rate, Path: []Currency{source, target}}, nil
+}
+
+// peggedQuote derives the exchange rate from the source to target currency by replacing
+// each pegged currency with its anchor and applying the fixed peg rate.
+// The source and date are those of the rate between the anchors.
+func peggedQuote(rates exchangeRates, source, target Currency) (RateQuote, error) {
+	from, fromFactor := source, ExchangeRate(1)
+	if peg, found := source.Peg(); found {
+		// note: 1 / (anchor -> source) == source -> anchor
+		from, fromFactor = peg.Anchor(), 1/peg.rate
+	}
+
+	to, toFactor := target, ExchangeRate(1)
+	if peg, found := target.Peg(); found {
+		to, toFactor = peg.Anchor(), peg.rate
+	}
+
+	if from.code == source.code && to.code == target.code {
+		return RateQuote{}, ErrNotPegged
+	}
+
+	anchorQuote := RateQuote{Rate: 1, Path: []Currency{from}}
+	if from.code != to.code {
+		var err error
+		anchorQuote, err = directQuote(rates, from, to)
+		if err != nil {
+			return RateQuote{}, err
+		}
+	}
+
+	path := append([]Currency(nil), anchorQuote.Path...)
+	if from.code != source.code {
+		path = append([]Currency{source}, path...)
+	}
+	if to.code != target.code {
+		path = append(path, target)
+	}
+
+	return RateQuote{
+		Rate:   fromFactor * anchorQuote.Rate * toFactor,
+		Source: anchorQuote.Source,
+		Date:   anchorQuote.Date,
+		Path:   path,
+	}, nil
+}
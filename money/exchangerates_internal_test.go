@@ -0,0 +1,117 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+const errFakeRateNotFound = Error("fake rate not found")
+
+// fakeRates is an exchange rate provider backed by a map of "SOURCE/TARGET" quotes.
+type fakeRates map[string]ExchangeRate
+
+// FetchExchangeRate implements the exchangeRates interface.
+func (f fakeRates) FetchExchangeRate(source, target Currency) (ExchangeRate, error) {
+	if source.code == target.code {
+		return 1, nil
+	}
+
+	rate, found := f[source.code+"/"+target.code]
+	if !found {
+		return 0, errFakeRateNotFound
+	}
+
+	return rate, nil
+}
+
+// mustCurrency parses a currency code for internal tests.
+func mustCurrency(t *testing.T, code string) Currency {
+	t.Helper()
+
+	currency, err := ParseCurrency(code)
+	if err != nil {
+		t.Fatalf("could not parse currency %q: %s", code, err.Error())
+	}
+
+	return currency
+}
+
+func TestFetchExchangeRate(t *testing.T) {
+	type testCase struct {
+		rates   fakeRates
+		source  string
+		target  string
+		want    ExchangeRate
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"direct quote": {
+			rates:  fakeRates{"XOF/USD": 0.0017},
+			source: "XOF",
+			target: "USD",
+			want:   0.0017,
+		},
+		"pegged source to its anchor": {
+			rates:  fakeRates{},
+			source: "XOF",
+			target: "EUR",
+			want:   1 / 655.957,
+		},
+		"anchor to pegged target": {
+			rates:  fakeRates{},
+			source: "USD",
+			target: "SAR",
+			want:   3.75,
+		},
+		"pegged source through anchor rate": {
+			rates:  fakeRates{"EUR/USD": 1.08},
+			source: "XOF",
+			target: "USD",
+			want:   1.08 / 655.957,
+		},
+		"pegged target through anchor rate": {
+			rates:  fakeRates{"EUR/USD": 1.08},
+			source: "EUR",
+			target: "AED",
+			want:   1.08 * 3.6725,
+		},
+		"both pegged to the same anchor": {
+			rates:  fakeRates{},
+			source: "XOF",
+			target: "XAF",
+			want:   1,
+		},
+		"both pegged to different anchors": {
+			rates:  fakeRates{"EUR/USD": 1.08},
+			source: "XOF",
+			target: "SAR",
+			want:   1 / 655.957 * 1.08 * 3.75,
+		},
+		"not pegged returns the provider error": {
+			rates:   fakeRates{},
+			source:  "USD",
+			target:  "CAD",
+			wantErr: errFakeRateNotFound,
+		},
+		"missing anchor rate returns the provider error": {
+			rates:   fakeRates{},
+			source:  "XOF",
+			target:  "CAD",
+			wantErr: errFakeRateNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := fetchExchangeRate(tc.rates, mustCurrency(t, tc.source), mustCurrency(t, tc.target))
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if math.Abs(float64(got-tc.want)) > 1e-12 {
+				t.Errorf("got: %g, want: %g", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package money
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Formatter renders Amounts for display following the conventions of a locale,
+// e.g. "$1,234.50" for en-US, "1.234,50 €" for de-DE and "₹12,34,567.00" for hi-IN.
+type Formatter struct {
+	locale      *locale
+	useCode     bool
+	accounting  bool
+	minFraction int
+	mode        RoundingMode
+}
+
+// FormatOption configures a Formatter.
+type FormatOption func(*Formatter)
+
+// WithCurrencyCode shows the ISO code of the currency instead of its symbol, e.g. "USD 1,234.50".
+func WithCurrencyCode() FormatOption {
+	return func(f *Formatter) {
+		f.useCode = true
+	}
+}
+
+// WithAccounting shows negative amounts the way the locale does in accounting, e.g. "($1,234.50)".
+func WithAccounting() FormatOption {
+	return func(f *Formatter) {
+		f.accounting = true
+	}
+}
+
+// WithMinFractionDigits removes trailing fraction zeroes down to the given number of digits,
+// e.g. "$1,234.5" for 1 or "$1,234" for 0. By default all of the currency's fraction digits are shown.
+func WithMinFractionDigits(digits int) FormatOption {
+	return func(f *Formatter) {
+		f.minFraction = digits
+	}
+}
+
+// WithRoundingMode sets how amounts more precise than the currency's displayed fraction digits are rounded,
+// e.g. 1234.50 JPY is shown as "￥1,235" with the default RoundHalfUp.
+func WithRoundingMode(mode RoundingMode) FormatOption {
+	return func(f *Formatter) {
+		f.mode = mode
+	}
+}
+
+// NewFormatter creates a Formatter for a language tag such as "en-US" or "de_DE".
+// A tag with an unknown region falls back to the language's default region.
+func NewFormatter(tag string, options ...FormatOption) (Formatter, error) {
+	loc, err := lookupLocale(tag)
+	if err != nil {
+		return Formatter{}, err
+	}
+
+	formatter := Formatter{locale: loc, minFraction: -1, mode: RoundHalfUp}
+	for _, option := range options {
+		option(&formatter)
+	}
+
+	return formatter, nil
+}
+
+// Format renders the Amount.
+func (f Formatter) Format(a Amount) string {
+	digits := fractionDigits(a.currency)
+	rounded := a.quantity.Round(digits, f.mode)
+
+	pattern := f.locale.positive
+	switch {
+	case rounded.units < 0 && f.accounting:
+		pattern = f.locale.accounting
+	case rounded.units < 0:
+		pattern = f.locale.negative
+	}
+
+	symbol := f.locale.symbol(a.currency)
+	if f.useCode {
+		symbol = a.currency.code
+	}
+
+	number := f.formatNumber(rounded, digits)
+
+	var b strings.Builder
+	for i, r := range pattern {
+		switch r {
+		case '¤':
+			b.WriteString(symbol)
+			// separate symbols such as "CHF" from adjacent digits, as CLDR currency spacing does.
+			if strings.HasPrefix(pattern[i+len("¤"):], "#") && endsWithLetter(symbol) {
+				b.WriteString("\u00a0")
+			}
+		case '#':
+			b.WriteString(number)
+			if strings.HasPrefix(pattern[i+len("#"):], "¤") && startsWithLetter(symbol) {
+				b.WriteString("\u00a0")
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// formatNumber renders the magnitude of the Decimal with the locale's separators and the given fraction digits.
+func (f Formatter) formatNumber(d Decimal, digits uint8) string {
+	// the magnitude is unsigned so that the smallest int64 can be negated.
+	magnitude := uint64(d.units)
+	if d.units < 0 {
+		magnitude = -magnitude
+	}
+
+	text := strconv.FormatUint(magnitude, 10)
+	if pad := int(d.precision) + 1 - len(text); pad > 0 {
+		text = strings.Repeat("0", pad) + text
+	}
+
+	integer := text[:len(text)-int(d.precision)]
+	fraction := text[len(text)-int(d.precision):] + strings.Repeat("0", int(digits-d.precision))
+
+	if f.minFraction >= 0 {
+		for len(fraction) > f.minFraction && strings.HasSuffix(fraction, "0") {
+			fraction = fraction[:len(fraction)-1]
+		}
+	}
+
+	number := f.locale.groupDigits(integer)
+	if fraction != "" {
+		number += f.locale.decimal + fraction
+	}
+
+	return number
+}
+
+// groupDigits inserts the locale's group separator into a string of integer digits.
+func (l *locale) groupDigits(integer string) string {
+	if len(integer) < l.primaryGroup+l.minimumGrouping {
+		return integer
+	}
+
+	groups := []string{integer[len(integer)-l.primaryGroup:]}
+	rest := integer[:len(integer)-l.primaryGroup]
+
+	for len(rest) > l.secondaryGroup {
+		groups = append(groups, rest[len(rest)-l.secondaryGroup:])
+		rest = rest[:len(rest)-l.secondaryGroup]
+	}
+	groups = append(groups, rest)
+
+	// the groups were collected from the right.
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+
+	return strings.Join(groups, l.group)
+}
+
+// startsWithLetter reports whether the first character of the text is a letter.
+func startsWithLetter(text string) bool {
+	r, _ := utf8.DecodeRuneInString(text)
+	return unicode.IsLetter(r)
+}
+
+// endsWithLetter reports whether the last character of the text is a letter.
+func endsWithLetter(text string) bool {
+	r, _ := utf8.DecodeLastRuneInString(text)
+	return unicode.IsLetter(r)
+}
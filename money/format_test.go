@@ -0,0 +1,184 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+// mustParseAmount ensures that testing code correctly creates the Amount.
+func mustParseAmount(t *testing.T, value, code string) money.Amount {
+	t.Helper()
+
+	currency, err := money.ParseCurrency(code)
+	if err != nil {
+		t.Fatalf("got error when parsing currency: %s", err.Error())
+	}
+
+	amount, err := money.NewAmount(mustParseDecimal(t, value), currency)
+	if err != nil {
+		t.Fatalf("got error when creating amount: %s", err.Error())
+	}
+
+	return amount
+}
+
+func TestFormatterFormat(t *testing.T) {
+	type testCase struct {
+		locale  string
+		options []money.FormatOption
+		amount  money.Amount
+		want    string
+	}
+
+	testCases := map[string]testCase{
+		"en-US": {
+			locale: "en-US",
+			amount: mustParseAmount(t, "1234.5", "USD"),
+			want:   "$1,234.50",
+		},
+		"en-US negative": {
+			locale: "en-US",
+			amount: mustParseAmount(t, "-1234.5", "USD"),
+			want:   "-$1,234.50",
+		},
+		"en-US accounting": {
+			locale:  "en-US",
+			options: []money.FormatOption{money.WithAccounting()},
+			amount:  mustParseAmount(t, "-1234.5", "USD"),
+			want:    "($1,234.50)",
+		},
+		"en-US accounting positive": {
+			locale:  "en-US",
+			options: []money.FormatOption{money.WithAccounting()},
+			amount:  mustParseAmount(t, "1234.5", "USD"),
+			want:    "$1,234.50",
+		},
+		"en-US currency code": {
+			locale:  "en-US",
+			options: []money.FormatOption{money.WithCurrencyCode()},
+			amount:  mustParseAmount(t, "1234.5", "USD"),
+			want:    "USD\u00a01,234.50",
+		},
+		"en-US without a symbol": {
+			locale: "en-US",
+			amount: mustParseAmount(t, "1234.5", "CHF"),
+			want:   "CHF\u00a01,234.50",
+		},
+		"en-US millions": {
+			locale: "en-US",
+			amount: mustParseAmount(t, "1234567.89", "EUR"),
+			want:   "€1,234,567.89",
+		},
+		"en-US small": {
+			locale: "en-US",
+			amount: mustParseAmount(t, "0.05", "USD"),
+			want:   "$0.05",
+		},
+		"de-DE": {
+			locale: "de-DE",
+			amount: mustParseAmount(t, "1234.5", "EUR"),
+			want:   "1.234,50\u00a0€",
+		},
+		"de-DE negative": {
+			locale: "de_de",
+			amount: mustParseAmount(t, "-1234.5", "EUR"),
+			want:   "-1.234,50\u00a0€",
+		},
+		"de-CH": {
+			locale: "de-CH",
+			amount: mustParseAmount(t, "1234.5", "CHF"),
+			want:   "CHF\u00a01’234.50",
+		},
+		"fr-CH": {
+			locale: "fr-CH",
+			amount: mustParseAmount(t, "1234.5", "CHF"),
+			want:   "1\u202f234,50\u00a0CHF",
+		},
+		"fr-FR accounting": {
+			locale:  "fr-FR",
+			options: []money.FormatOption{money.WithAccounting()},
+			amount:  mustParseAmount(t, "-1234.5", "EUR"),
+			want:    "(1\u202f234,50\u00a0€)",
+		},
+		"ja-JP": {
+			locale: "ja-JP",
+			amount: mustParseAmount(t, "1234.5", "JPY"),
+			want:   "￥1,235",
+		},
+		"ja-JP half even": {
+			locale:  "ja-JP",
+			options: []money.FormatOption{money.WithRoundingMode(money.RoundHalfEven)},
+			amount:  mustParseAmount(t, "1234.5", "JPY"),
+			want:    "￥1,234",
+		},
+		"hi-IN lakh grouping": {
+			locale: "hi-IN",
+			amount: mustParseAmount(t, "1234567.5", "INR"),
+			want:   "₹12,34,567.50",
+		},
+		"hi-IN crore grouping": {
+			locale: "hi-IN",
+			amount: mustParseAmount(t, "123456789", "INR"),
+			want:   "₹12,34,56,789.00",
+		},
+		"es-ES minimum grouping": {
+			locale: "es-ES",
+			amount: mustParseAmount(t, "1234.5", "EUR"),
+			want:   "1234,50\u00a0€",
+		},
+		"es-ES grouped": {
+			locale: "es-ES",
+			amount: mustParseAmount(t, "12345.5", "EUR"),
+			want:   "12.345,50\u00a0€",
+		},
+		"language only": {
+			locale: "en",
+			amount: mustParseAmount(t, "1", "USD"),
+			want:   "$1.00",
+		},
+		"unknown region": {
+			locale: "de-AT",
+			amount: mustParseAmount(t, "1", "EUR"),
+			want:   "1,00\u00a0€",
+		},
+		"minimum fraction digits": {
+			locale:  "en-US",
+			options: []money.FormatOption{money.WithMinFractionDigits(0)},
+			amount:  mustParseAmount(t, "1234.5", "USD"),
+			want:    "$1,234.5",
+		},
+		"minimum fraction digits of a whole amount": {
+			locale:  "en-US",
+			options: []money.FormatOption{money.WithMinFractionDigits(0)},
+			amount:  mustParseAmount(t, "1234", "USD"),
+			want:    "$1,234",
+		},
+		"three decimal currency": {
+			locale: "en-US",
+			amount: mustParseAmount(t, "-1.5", "KWD"),
+			want:   "-KWD\u00a01.500",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			formatter, err := money.NewFormatter(tc.locale, tc.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got := formatter.Format(tc.amount); got != tc.want {
+				t.Errorf("got: %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewFormatter_UnknownLocale(t *testing.T) {
+	_, err := money.NewFormatter("xx-XX")
+	if !errors.Is(err, money.ErrUnknownLocale) {
+		t.Errorf("got err: %v, want: %v", err, money.ErrUnknownLocale)
+	}
+}
@@ -0,0 +1,222 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ErrUnknownLocale is returned when no formatting conventions are known for a locale.
+	ErrUnknownLocale = Error("unknown locale")
+)
+
+// locale holds the number and currency conventions of a locale.
+// The data is a subset of the Unicode CLDR, see https://cldr.unicode.org.
+type locale struct {
+	// decimal separates the fraction from the integer part.
+	decimal string
+	// group separates groups of integer digits.
+	group string
+	// primaryGroup is the size of the group nearest the decimal separator and secondaryGroup the size of
+	// the others, e.g. 3 and 2 for 12,34,567.
+	primaryGroup   int
+	secondaryGroup int
+	// minimumGrouping is the number of digits the leading group needs before grouping is used,
+	// e.g. 2 formats 1234 as "1234" but 12345 as "12.345".
+	minimumGrouping int
+	// positive, negative and accounting are the currency patterns for positive, negative and
+	// accounting negative amounts, where ¤ is replaced by the symbol, # by the number and - by the minus sign.
+	positive   string
+	negative   string
+	accounting string
+	// symbols maps ISO codes to the locale's currency symbols. Other currencies are shown by their code.
+	symbols map[string]string
+}
+
+// currencyDigits contains the number of fraction digits CLDR displays for currencies
+// whose convention differs from the currency's precision.
+var currencyDigits = map[string]uint8{
+	"CLP": 0, // Chilean Peso
+	"ISK": 0, // Icelandic Króna
+	"JPY": 0, // Japanese Yen
+	"KRW": 0, // South Korean Won
+	"PYG": 0, // Paraguayan Guaraní
+	"UGX": 0, // Ugandan Shilling
+	"VND": 0, // Vietnamese Dong
+	"XAF": 0, // Central African CFA Franc
+	"XOF": 0, // West African CFA Franc
+}
+
+// locales contains the formatting conventions known to the package, keyed by lower case language tag.
+var locales = map[string]*locale{
+	"en-us": {
+		decimal: ".", group: ",", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤#", negative: "-¤#", accounting: "(¤#)",
+		symbols: map[string]string{
+			"AUD": "A$", "BRL": "R$", "CAD": "CA$", "CNY": "CN¥", "EUR": "€", "GBP": "£", "HKD": "HK$",
+			"INR": "₹", "JPY": "¥", "KRW": "₩", "MXN": "MX$", "NZD": "NZ$", "USD": "$",
+		},
+	},
+	"en-gb": {
+		decimal: ".", group: ",", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤#", negative: "-¤#", accounting: "(¤#)",
+		symbols: map[string]string{
+			"AUD": "A$", "CAD": "CA$", "CNY": "CN¥", "EUR": "€", "GBP": "£", "INR": "₹", "JPY": "JP¥", "USD": "US$",
+		},
+	},
+	"en-ca": {
+		decimal: ".", group: ",", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤#", negative: "-¤#", accounting: "(¤#)",
+		symbols: map[string]string{
+			"CAD": "$", "EUR": "€", "GBP": "£", "JPY": "JP¥", "USD": "US$",
+		},
+	},
+	"en-in": {
+		decimal: ".", group: ",", primaryGroup: 3, secondaryGroup: 2, minimumGrouping: 1,
+		positive: "¤#", negative: "-¤#", accounting: "(¤#)",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "£", "INR": "₹", "JPY": "JP¥", "USD": "$",
+		},
+	},
+	"hi-in": {
+		decimal: ".", group: ",", primaryGroup: 3, secondaryGroup: 2, minimumGrouping: 1,
+		positive: "¤#", negative: "-¤#", accounting: "-¤#",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "£", "INR": "₹", "JPY": "JP¥", "USD": "$",
+		},
+	},
+	"de-de": {
+		decimal: ",", group: ".", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "#\u00a0¤", negative: "-#\u00a0¤", accounting: "-#\u00a0¤",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "£", "JPY": "¥", "USD": "$",
+		},
+	},
+	"de-ch": {
+		decimal: ".", group: "’", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤\u00a0#", negative: "¤-#", accounting: "¤-#",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "£", "JPY": "¥", "USD": "$",
+		},
+	},
+	"fr-fr": {
+		decimal: ",", group: "\u202f", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "#\u00a0¤", negative: "-#\u00a0¤", accounting: "(#\u00a0¤)",
+		symbols: map[string]string{
+			"CAD": "$CA", "EUR": "€", "GBP": "£GB", "USD": "$US",
+		},
+	},
+	"fr-ch": {
+		decimal: ",", group: "\u202f", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "#\u00a0¤", negative: "-#\u00a0¤", accounting: "(#\u00a0¤)",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "£GB", "USD": "$US",
+		},
+	},
+	"fr-ca": {
+		decimal: ",", group: "\u00a0", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "#\u00a0¤", negative: "-#\u00a0¤", accounting: "(#\u00a0¤)",
+		symbols: map[string]string{
+			"CAD": "$", "EUR": "€", "GBP": "£", "USD": "$\u00a0US",
+		},
+	},
+	"es-es": {
+		decimal: ",", group: ".", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 2,
+		positive: "#\u00a0¤", negative: "-#\u00a0¤", accounting: "-#\u00a0¤",
+		symbols: map[string]string{
+			"EUR": "€", "USD": "US$",
+		},
+	},
+	"it-it": {
+		decimal: ",", group: ".", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "#\u00a0¤", negative: "-#\u00a0¤", accounting: "-#\u00a0¤",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "£", "JPY": "JP¥",
+		},
+	},
+	"nl-nl": {
+		decimal: ",", group: ".", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤\u00a0#", negative: "¤\u00a0-#", accounting: "(¤\u00a0#)",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "£", "JPY": "JP¥", "USD": "US$",
+		},
+	},
+	"pl-pl": {
+		decimal: ",", group: "\u00a0", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 2,
+		positive: "#\u00a0¤", negative: "-#\u00a0¤", accounting: "(#\u00a0¤)",
+		symbols: map[string]string{
+			"EUR": "€", "GBP": "GBP", "PLN": "zł",
+		},
+	},
+	"pt-br": {
+		decimal: ",", group: ".", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤\u00a0#", negative: "-¤\u00a0#", accounting: "-¤\u00a0#",
+		symbols: map[string]string{
+			"BRL": "R$", "EUR": "€", "GBP": "£", "USD": "US$",
+		},
+	},
+	"ja-jp": {
+		decimal: ".", group: ",", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤#", negative: "-¤#", accounting: "(¤#)",
+		symbols: map[string]string{
+			"CNY": "元", "EUR": "€", "GBP": "£", "JPY": "￥", "USD": "$",
+		},
+	},
+	"zh-cn": {
+		decimal: ".", group: ",", primaryGroup: 3, secondaryGroup: 3, minimumGrouping: 1,
+		positive: "¤#", negative: "-¤#", accounting: "(¤#)",
+		symbols: map[string]string{
+			"CNY": "¥", "EUR": "€", "GBP": "£", "JPY": "JP¥", "USD": "US$",
+		},
+	},
+}
+
+// defaultRegions maps languages to the locale used when only the language is given.
+var defaultRegions = map[string]string{
+	"de": "de-de",
+	"en": "en-us",
+	"es": "es-es",
+	"fr": "fr-fr",
+	"hi": "hi-in",
+	"it": "it-it",
+	"ja": "ja-jp",
+	"nl": "nl-nl",
+	"pl": "pl-pl",
+	"pt": "pt-br",
+	"zh": "zh-cn",
+}
+
+// lookupLocale finds the conventions for a language tag such as "de-DE" or "de_DE",
+// falling back to the default region of the language.
+func lookupLocale(tag string) (*locale, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+
+	if loc, found := locales[normalized]; found {
+		return loc, nil
+	}
+
+	language, _, _ := strings.Cut(normalized, "-")
+	if region, found := defaultRegions[language]; found {
+		return locales[region], nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownLocale, tag)
+}
+
+// symbol returns the locale's symbol for the currency, or its ISO code when the locale has none.
+func (l *locale) symbol(currency Currency) string {
+	if symbol, found := l.symbols[currency.code]; found {
+		return symbol
+	}
+
+	return currency.code
+}
+
+// fractionDigits returns the number of fraction digits displayed for the currency.
+func fractionDigits(currency Currency) uint8 {
+	if digits, found := currencyDigits[currency.code]; found && digits < currency.precision {
+		return digits
+	}
+
+	return currency.precision
+}
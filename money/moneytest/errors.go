@@ -0,0 +1,9 @@
+package moneytest
+
+// moneytestError defines a sentinel error.
+type moneytestError string
+
+// Error implements the error interface.
+func (e moneytestError) Error() string {
+	return string(e)
+}
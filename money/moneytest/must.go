@@ -0,0 +1,44 @@
+package moneytest
+
+import (
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+// MustCurrency parses the currency code, failing the test when it is invalid.
+func MustCurrency(t testing.TB, code string) money.Currency {
+	t.Helper()
+
+	currency, err := money.ParseCurrency(code)
+	if err != nil {
+		t.Fatalf("cannot parse currency code %q: %s", code, err.Error())
+	}
+
+	return currency
+}
+
+// MustDecimal parses the decimal, failing the test when it is invalid.
+func MustDecimal(t testing.TB, value string) money.Decimal {
+	t.Helper()
+
+	decimal, err := money.ParseDecimal(value)
+	if err != nil {
+		t.Fatalf("cannot parse decimal %q: %s", value, err.Error())
+	}
+
+	return decimal
+}
+
+// MustAmount creates the Amount of the value in the currency, e.g. MustAmount(t, "12.34", "USD"),
+// failing the test when either is invalid or the value is too precise for the currency.
+func MustAmount(t testing.TB, value string, code string) money.Amount {
+	t.Helper()
+
+	amount, err := money.NewAmount(MustDecimal(t, value), MustCurrency(t, code))
+	if err != nil {
+		t.Fatalf("cannot create amount %s %s: %s", value, code, err.Error())
+	}
+
+	return amount
+}
@@ -0,0 +1,148 @@
+// Package moneytest provides utilities for testing code that uses the money package.
+package moneytest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+const (
+	// ErrRateNotFound is returned by a FakeProvider for pairs without a rate.
+	ErrRateNotFound = moneytestError("moneytest: exchange rate not found")
+)
+
+// FakeProvider is an exchange rate provider with programmable rates, errors and latency,
+// which counts the lookups of each pair. It can be passed wherever the money package expects
+// a provider, e.g. money.Convert. It is safe for concurrent use.
+//
+// A pair of the same currency has a rate of 1 unless set otherwise.
+type FakeProvider struct {
+	mu      sync.Mutex
+	rates   map[string]money.ExchangeRate
+	errs    map[string]error
+	failAll error
+	latency time.Duration
+	calls   map[string]int
+}
+
+// NewFakeProvider creates a FakeProvider without any rates.
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		rates: make(map[string]money.ExchangeRate),
+		errs:  make(map[string]error),
+		calls: make(map[string]int),
+	}
+}
+
+// SetRate sets the exchange rate from the source to the target currency.
+// The reverse pair is not set.
+func (p *FakeProvider) SetRate(source, target money.Currency, rate money.ExchangeRate) *FakeProvider {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.rates[pairKey(source, target)] = rate
+	return p
+}
+
+// SetError makes lookups of the pair fail with the error, or clears the error when it is nil.
+func (p *FakeProvider) SetError(source, target money.Currency, err error) *FakeProvider {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err == nil {
+		delete(p.errs, pairKey(source, target))
+	} else {
+		p.errs[pairKey(source, target)] = err
+	}
+
+	return p
+}
+
+// FailAll makes every lookup fail with the error, or clears the error when it is nil.
+// It takes precedence over the errors of SetError.
+func (p *FakeProvider) FailAll(err error) *FakeProvider {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.failAll = err
+	return p
+}
+
+// SetLatency delays every lookup by the duration.
+func (p *FakeProvider) SetLatency(latency time.Duration) *FakeProvider {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.latency = latency
+	return p
+}
+
+// FetchExchangeRate returns the rate set for the pair, after the latency. Lookups are counted even when they fail.
+func (p *FakeProvider) FetchExchangeRate(source, target money.Currency) (money.ExchangeRate, error) {
+	key := pairKey(source, target)
+
+	p.mu.Lock()
+	p.calls[key]++
+	latency := p.latency
+	p.mu.Unlock()
+
+	// sleep without the lock so that concurrent lookups overlap like real requests.
+	time.Sleep(latency)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failAll != nil {
+		return 0, p.failAll
+	}
+
+	if err, found := p.errs[key]; found {
+		return 0, err
+	}
+
+	if rate, found := p.rates[key]; found {
+		return rate, nil
+	}
+
+	if source.ISOCode() == target.ISOCode() {
+		return 1, nil
+	}
+
+	return 0, ErrRateNotFound
+}
+
+// Calls returns the number of lookups of the pair.
+func (p *FakeProvider) Calls(source, target money.Currency) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.calls[pairKey(source, target)]
+}
+
+// TotalCalls returns the number of lookups of every pair.
+func (p *FakeProvider) TotalCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	total := 0
+	for _, count := range p.calls {
+		total += count
+	}
+
+	return total
+}
+
+// ResetCalls sets the lookup counts back to zero.
+func (p *FakeProvider) ResetCalls() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	clear(p.calls)
+}
+
+// pairKey identifies a currency pair, e.g. "USD/EUR".
+func pairKey(source, target money.Currency) string {
+	return source.ISOCode() + "/" + target.ISOCode()
+}
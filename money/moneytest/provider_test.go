@@ -0,0 +1,126 @@
+package moneytest_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+	"github.com/th3oth3rjak3/MoneyConverter/money/moneytest"
+)
+
+func TestFakeProvider(t *testing.T) {
+	usd, eur, gbp := moneytest.MustCurrency(t, "USD"), moneytest.MustCurrency(t, "EUR"), moneytest.MustCurrency(t, "GBP")
+	errPair := errors.New("pair unavailable")
+	errAll := errors.New("provider unavailable")
+
+	type testCase struct {
+		provider *moneytest.FakeProvider
+		source   money.Currency
+		target   money.Currency
+		want     money.ExchangeRate
+		wantErr  error
+	}
+
+	testCases := map[string]testCase{
+		"rate": {
+			provider: moneytest.NewFakeProvider().SetRate(usd, eur, 0.9),
+			source:   usd,
+			target:   eur,
+			want:     0.9,
+		},
+		"reverse pair is not set": {
+			provider: moneytest.NewFakeProvider().SetRate(usd, eur, 0.9),
+			source:   eur,
+			target:   usd,
+			wantErr:  moneytest.ErrRateNotFound,
+		},
+		"same currency": {
+			provider: moneytest.NewFakeProvider(),
+			source:   gbp,
+			target:   gbp,
+			want:     1,
+		},
+		"pair error": {
+			provider: moneytest.NewFakeProvider().SetRate(usd, eur, 0.9).SetError(usd, eur, errPair),
+			source:   usd,
+			target:   eur,
+			wantErr:  errPair,
+		},
+		"cleared pair error": {
+			provider: moneytest.NewFakeProvider().SetRate(usd, eur, 0.9).SetError(usd, eur, errPair).SetError(usd, eur, nil),
+			source:   usd,
+			target:   eur,
+			want:     0.9,
+		},
+		"every pair fails": {
+			provider: moneytest.NewFakeProvider().SetRate(usd, eur, 0.9).FailAll(errAll),
+			source:   usd,
+			target:   eur,
+			wantErr:  errAll,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.provider.FetchExchangeRate(tc.source, tc.target)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+
+			if got != tc.want {
+				t.Errorf("got: %v, want: %v", got, tc.want)
+			}
+
+			if calls := tc.provider.Calls(tc.source, tc.target); calls != 1 {
+				t.Errorf("got %d calls, want 1", calls)
+			}
+		})
+	}
+}
+
+func TestFakeProvider_Calls(t *testing.T) {
+	usd, eur := moneytest.MustCurrency(t, "USD"), moneytest.MustCurrency(t, "EUR")
+	provider := moneytest.NewFakeProvider().SetRate(usd, eur, 0.9).SetLatency(20 * time.Millisecond)
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = provider.FetchExchangeRate(usd, eur)
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("lookups took %s, want at least the latency", elapsed)
+	}
+
+	if _, err := provider.FetchExchangeRate(eur, usd); !errors.Is(err, moneytest.ErrRateNotFound) {
+		t.Errorf("got err: %v, want: %v", err, moneytest.ErrRateNotFound)
+	}
+
+	if calls := provider.Calls(usd, eur); calls != 5 {
+		t.Errorf("got %d calls, want 5", calls)
+	}
+
+	if total := provider.TotalCalls(); total != 6 {
+		t.Errorf("got %d total calls, want 6", total)
+	}
+
+	provider.ResetCalls()
+	if total := provider.TotalCalls(); total != 0 {
+		t.Errorf("got %d total calls after reset, want 0", total)
+	}
+}
+
+func TestMustAmount(t *testing.T) {
+	amount := moneytest.MustAmount(t, "12.3", "USD")
+	if got, want := amount.String(), "12.30 USD"; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
@@ -0,0 +1,407 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// ErrInvalidAmount is returned when text cannot be read as an Amount.
+	ErrInvalidAmount = Error("invalid amount")
+	// ErrMissingCurrency is returned when text has no currency and the Parser has no default currency.
+	ErrMissingCurrency = Error("missing currency")
+	// ErrUnknownCurrency is returned when a currency symbol cannot be matched to a single currency.
+	ErrUnknownCurrency = Error("unknown currency symbol")
+)
+
+// Parser reads Amounts written in the conventions of a locale,
+// e.g. "€1.234,56" for de-DE, "1,234.56 USD" or "USD 12" for en-US.
+//
+// By default parsing is lenient: grouping may use spaces or apostrophes as well as the locale's separator,
+// a separator that differs from the locale's is accepted when its position makes it unambiguous,
+// scientific notation such as "1.5e3" is accepted and symbols of other locales are recognised when unique.
+// Strict parsing only accepts the locale's separators in their grouping positions, the locale's symbols
+// and ISO codes.
+type Parser struct {
+	locale          *locale
+	strict          bool
+	defaultCurrency *Currency
+}
+
+// ParseOption configures a Parser.
+type ParseOption func(*Parser)
+
+// WithStrictParsing only accepts text that follows the locale's conventions exactly.
+func WithStrictParsing() ParseOption {
+	return func(p *Parser) {
+		p.strict = true
+	}
+}
+
+// WithDefaultCurrency sets the currency of text that does not contain one.
+func WithDefaultCurrency(currency Currency) ParseOption {
+	return func(p *Parser) {
+		p.defaultCurrency = &currency
+	}
+}
+
+// NewParser creates a Parser for a language tag such as "en-US" or "de_DE".
+// A tag with an unknown region falls back to the language's default region.
+func NewParser(tag string, options ...ParseOption) (Parser, error) {
+	loc, err := lookupLocale(tag)
+	if err != nil {
+		return Parser{}, err
+	}
+
+	parser := Parser{locale: loc}
+	for _, option := range options {
+		option(&parser)
+	}
+
+	return parser, nil
+}
+
+// Parse reads the text as an Amount. Surrounding whitespace, a leading sign and accounting parentheses are
+// accepted, and the currency may be given by symbol or ISO code before or after the number.
+func (p Parser) Parse(text string) (Amount, error) {
+	text = strings.TrimFunc(text, isSpace)
+
+	negative := false
+	if strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")") {
+		negative = true
+		text = strings.TrimFunc(text[1:len(text)-1], isSpace)
+	}
+
+	text, signed := trimSign(text)
+	negative = negative != signed
+
+	prefix, number, suffix := splitCurrency(text)
+
+	// the sign may also follow the symbol, e.g. "CHF-1'234.50".
+	prefix, signed = trimTrailingSign(prefix)
+	negative = negative != signed
+
+	currency, err := p.currency(prefix, suffix)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	quantity, err := p.parseNumber(number)
+	if err != nil {
+		return Amount{}, fmt.Errorf("%w %q: %w", ErrInvalidAmount, text, err)
+	}
+
+	if negative {
+		quantity.units = -quantity.units
+	}
+
+	return NewAmount(quantity, currency)
+}
+
+// currency resolves the currency written before or after the number.
+func (p Parser) currency(prefix, suffix string) (Currency, error) {
+	prefix = strings.TrimFunc(prefix, isSpace)
+	suffix = strings.TrimFunc(suffix, isSpace)
+
+	var written string
+	switch {
+	case prefix != "" && suffix != "":
+		return Currency{}, fmt.Errorf("%w: currency given twice, %q and %q", ErrInvalidAmount, prefix, suffix)
+	case prefix != "":
+		written = prefix
+	case suffix != "":
+		written = suffix
+	case p.defaultCurrency != nil:
+		return *p.defaultCurrency, nil
+	default:
+		return Currency{}, ErrMissingCurrency
+	}
+
+	if !p.strict {
+		written = strings.ToUpper(written)
+	}
+
+	if currency, err := ParseCurrency(written); err == nil {
+		return currency, nil
+	}
+
+	if code, found := p.locale.currencyForSymbol(written); found {
+		return ParseCurrency(code)
+	}
+
+	if !p.strict {
+		if code, found := currencyForSymbol(written); found {
+			return ParseCurrency(code)
+		}
+	}
+
+	return Currency{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, written)
+}
+
+// parseNumber reads the number, removing grouping and normalizing the decimal separator.
+func (p Parser) parseNumber(number string) (Decimal, error) {
+	mantissa, exponent := number, 0
+
+	if index := strings.IndexAny(number, "eE"); index >= 0 && !p.strict {
+		exp, err := strconv.Atoi(number[index+1:])
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid exponent %q", number[index+1:])
+		}
+		mantissa, exponent = number[:index], exp
+	}
+
+	var (
+		plain string
+		err   error
+	)
+
+	if p.strict {
+		plain, err = p.locale.normalizeStrict(mantissa)
+	} else {
+		plain, err = p.locale.normalizeLenient(mantissa)
+	}
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	decimal, err := ParseDecimal(plain)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return scaleByPowerOfTen(decimal, exponent)
+}
+
+// normalizeStrict converts a number written exactly in the locale's conventions into the form ParseDecimal reads.
+func (l *locale) normalizeStrict(number string) (string, error) {
+	integer, fraction, hasFraction := strings.Cut(number, l.decimal)
+	if hasFraction && (fraction == "" || !isDigits(fraction)) {
+		return "", fmt.Errorf("invalid fraction %q", fraction)
+	}
+
+	digits := strings.ReplaceAll(integer, l.group, "")
+	if digits == "" || !isDigits(digits) {
+		return "", fmt.Errorf("invalid number %q", number)
+	}
+
+	if integer != digits && integer != l.groupDigits(digits) {
+		return "", fmt.Errorf("misplaced group separator in %q", integer)
+	}
+
+	if !hasFraction {
+		return digits, nil
+	}
+
+	return digits + "." + fraction, nil
+}
+
+// normalizeLenient converts a number written with any common separators into the form ParseDecimal reads.
+// The last '.' or ',' is the decimal separator when both appear, and it must appear only once. When only one of them appears once,
+// it is the decimal separator if it is the locale's or if it is not followed by exactly three digits.
+// Every other separator groups the integer digits and must sit where the locale's group sizes place it,
+// so that e.g. "1.2.3" is rejected rather than read as 123.
+func (l *locale) normalizeLenient(number string) (string, error) {
+	var b strings.Builder
+	for _, r := range number {
+		if r == '\'' || r == '\u2019' || isSpace(r) || string(r) == l.group && r != '.' && r != ',' {
+			// any grouping other than '.' and ',' is written as an apostrophe to check its position below.
+			b.WriteRune('\'')
+			continue
+		}
+		b.WriteRune(r)
+	}
+	number = b.String()
+
+	decimalIndex := -1
+	dots, commas := strings.Count(number, "."), strings.Count(number, ",")
+
+	switch {
+	case dots > 0 && commas > 0:
+		decimalIndex = max(strings.LastIndex(number, "."), strings.LastIndex(number, ","))
+		if strings.Count(number, number[decimalIndex:decimalIndex+1]) > 1 {
+			return "", fmt.Errorf("invalid number %q", number)
+		}
+	case dots+commas == 1:
+		index := strings.IndexAny(number, ".,")
+		if number[index:index+1] == l.decimal || len(number)-index-1 != 3 {
+			decimalIndex = index
+		}
+	}
+
+	integer, fraction := number, ""
+	if decimalIndex >= 0 {
+		integer, fraction = number[:decimalIndex], number[decimalIndex+1:]
+	}
+
+	groups := strings.Split(strings.NewReplacer(".", "'", ",", "'").Replace(integer), "'")
+	if !l.isGrouped(groups) {
+		return "", fmt.Errorf("misplaced group separator in %q", integer)
+	}
+
+	integer = strings.Join(groups, "")
+	if !isDigits(integer+fraction) || integer+fraction == "" {
+		return "", fmt.Errorf("invalid number %q", number)
+	}
+
+	if fraction == "" {
+		return integer, nil
+	}
+
+	return integer + "." + fraction, nil
+}
+
+// isGrouped reports whether integer digits split at their group separators form the locale's groups:
+// the last group has the primary size, those before it the secondary size and the first at most that.
+// A single group has no separators and is always accepted.
+func (l *locale) isGrouped(groups []string) bool {
+	if len(groups) == 1 {
+		return true
+	}
+
+	last := len(groups) - 1
+	for i, group := range groups {
+		switch {
+		case i == last && len(group) != l.primaryGroup:
+			return false
+		case i == 0 && (group == "" || len(group) > l.secondaryGroup):
+			return false
+		case i > 0 && i < last && len(group) != l.secondaryGroup:
+			return false
+		}
+	}
+
+	return true
+}
+
+// currencyForSymbol finds the currency the locale uses the symbol for.
+func (l *locale) currencyForSymbol(symbol string) (string, bool) {
+	for code, candidate := range l.symbols {
+		if strings.EqualFold(candidate, symbol) {
+			return code, true
+		}
+	}
+
+	return "", false
+}
+
+// currencyForSymbol finds the currency a symbol stands for in any known locale, when it stands for only one.
+func currencyForSymbol(symbol string) (string, bool) {
+	found := ""
+
+	for _, loc := range locales {
+		code, ok := loc.currencyForSymbol(symbol)
+		switch {
+		case !ok:
+		case found == "":
+			found = code
+		case found != code:
+			return "", false
+		}
+	}
+
+	return found, found != ""
+}
+
+// scaleByPowerOfTen multiplies the Decimal by 10^exponent.
+func scaleByPowerOfTen(d Decimal, exponent int) (Decimal, error) {
+	if d.units == 0 {
+		return Decimal{}, nil
+	}
+
+	// 10^19 does not fit in an int64, so any larger exponent overflows a value that is not zero.
+	// Bounding it first also keeps the loops below short and the precision from wrapping around.
+	if exponent > maxPow10 || exponent < -maxPow10 {
+		return Decimal{}, ErrOverflow
+	}
+
+	switch {
+	case exponent < 0:
+		precision := int(d.precision) - exponent
+		// 10^19 no longer fits in an int64, so the scale could not be used for arithmetic.
+		if precision > 18 {
+			return Decimal{}, ErrOverflow
+		}
+		d.precision = uint8(precision)
+	case exponent > 0:
+		for ; exponent > 0 && d.precision > 0; exponent-- {
+			d.precision--
+		}
+		for ; exponent > 0; exponent-- {
+			if d.units > math.MaxInt64/10 || d.units < math.MinInt64/10 {
+				return Decimal{}, ErrOverflow
+			}
+			d.units *= 10
+		}
+	}
+
+	d.simplify()
+	return d, nil
+}
+
+// splitCurrency separates the text before the first digit and after the last digit from the number.
+// A separator directly followed by a digit is part of the number, e.g. ".5".
+func splitCurrency(text string) (prefix, number, suffix string) {
+	start := strings.IndexFunc(text, unicode.IsDigit)
+	if start < 0 {
+		return text, "", ""
+	}
+
+	if start > 0 && (text[start-1] == '.' || text[start-1] == ',') {
+		start--
+	}
+
+	end := strings.LastIndexFunc(text, unicode.IsDigit) + 1
+
+	return text[:start], text[start:end], text[end:]
+}
+
+// trimSign removes a leading sign from the text and reports whether it was negative.
+func trimSign(text string) (string, bool) {
+	text = strings.TrimFunc(text, isSpace)
+
+	switch {
+	case strings.HasPrefix(text, "-"):
+		return strings.TrimFunc(text[1:], isSpace), true
+	case strings.HasPrefix(text, "\u2212"):
+		return strings.TrimFunc(text[len("\u2212"):], isSpace), true
+	case strings.HasPrefix(text, "+"):
+		return strings.TrimFunc(text[1:], isSpace), false
+	default:
+		return text, false
+	}
+}
+
+// trimTrailingSign removes a sign from the end of the text and reports whether it was negative.
+func trimTrailingSign(text string) (string, bool) {
+	text = strings.TrimRightFunc(text, isSpace)
+
+	switch {
+	case strings.HasSuffix(text, "-"):
+		return text[:len(text)-1], true
+	case strings.HasSuffix(text, "\u2212"):
+		return text[:len(text)-len("\u2212")], true
+	case strings.HasSuffix(text, "+"):
+		return text[:len(text)-1], false
+	default:
+		return text, false
+	}
+}
+
+// isSpace reports whether the rune is whitespace, including the no-break spaces used for grouping.
+func isSpace(r rune) bool {
+	return unicode.IsSpace(r) || r == '\u00a0' || r == '\u202f'
+}
+
+// isDigits reports whether the text consists only of ASCII digits.
+func isDigits(text string) bool {
+	for _, r := range text {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
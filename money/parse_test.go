@@ -0,0 +1,303 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+// mustParseCurrency ensures that testing code correctly creates the Currency.
+func mustParseCurrency(t *testing.T, code string) money.Currency {
+	t.Helper()
+
+	currency, err := money.ParseCurrency(code)
+	if err != nil {
+		t.Fatalf("got error when parsing currency: %s", err.Error())
+	}
+
+	return currency
+}
+
+func TestParserParse(t *testing.T) {
+	type testCase struct {
+		locale  string
+		options []money.ParseOption
+		text    string
+		want    money.Amount
+	}
+
+	testCases := map[string]testCase{
+		"symbol prefix with comma decimal": {
+			locale: "de-DE",
+			text:   "€1.234,56",
+			want:   mustParseAmount(t, "1234.56", "EUR"),
+		},
+		"code suffix": {
+			locale: "en-US",
+			text:   "1,234.56 USD",
+			want:   mustParseAmount(t, "1234.56", "USD"),
+		},
+		"code prefix": {
+			locale: "en-US",
+			text:   "USD 12",
+			want:   mustParseAmount(t, "12", "USD"),
+		},
+		"lower case code": {
+			locale: "en-US",
+			text:   "12.5 eur",
+			want:   mustParseAmount(t, "12.5", "EUR"),
+		},
+		"locale symbol suffix": {
+			locale: "fr-FR",
+			text:   "1 234,56 €",
+			want:   mustParseAmount(t, "1234.56", "EUR"),
+		},
+		"surrounding whitespace": {
+			locale: "en-US",
+			text:   " \t$12.34\n",
+			want:   mustParseAmount(t, "12.34", "USD"),
+		},
+		"apostrophe grouping": {
+			locale: "de-CH",
+			text:   "CHF 1'234.50",
+			want:   mustParseAmount(t, "1234.5", "CHF"),
+		},
+		"foreign separators": {
+			locale: "de-DE",
+			text:   "1,234.56 USD",
+			want:   mustParseAmount(t, "1234.56", "USD"),
+		},
+		"single foreign separator followed by three digits is grouping": {
+			locale: "fr-FR",
+			text:   "1.234 EUR",
+			want:   mustParseAmount(t, "1234", "EUR"),
+		},
+		"single foreign separator is decimal": {
+			locale: "en-US",
+			text:   "12,5 EUR",
+			want:   mustParseAmount(t, "12.5", "EUR"),
+		},
+		"leading fraction separator": {
+			locale: "en-US",
+			text:   "$.5",
+			want:   mustParseAmount(t, "0.5", "USD"),
+		},
+		"scientific notation": {
+			locale: "en-US",
+			text:   "1.5e3 USD",
+			want:   mustParseAmount(t, "1500", "USD"),
+		},
+		"negative exponent": {
+			locale: "en-US",
+			text:   "125E-2 USD",
+			want:   mustParseAmount(t, "1.25", "USD"),
+		},
+		"leading minus": {
+			locale: "en-US",
+			text:   "-$1,234.50",
+			want:   mustParseAmount(t, "-1234.5", "USD"),
+		},
+		"minus after symbol": {
+			locale: "de-CH",
+			text:   "CHF-1’234.50",
+			want:   mustParseAmount(t, "-1234.5", "CHF"),
+		},
+		"accounting parentheses": {
+			locale: "en-US",
+			text:   "($1,234.50)",
+			want:   mustParseAmount(t, "-1234.5", "USD"),
+		},
+		"symbol of another locale": {
+			locale: "en-US",
+			text:   "12 zł",
+			want:   mustParseAmount(t, "12", "PLN"),
+		},
+		"locale symbol takes precedence": {
+			locale: "en-CA",
+			text:   "$12",
+			want:   mustParseAmount(t, "12", "CAD"),
+		},
+		"default currency": {
+			locale:  "en-US",
+			options: []money.ParseOption{money.WithDefaultCurrency(mustParseCurrency(t, "GBP"))},
+			text:    "1,000",
+			want:    mustParseAmount(t, "1000", "GBP"),
+		},
+		"zero with a large exponent": {
+			locale: "en-US",
+			text:   "0e2000000000 USD",
+			want:   mustParseAmount(t, "0", "USD"),
+		},
+		"zero with an exponent beyond int64": {
+			locale: "en-US",
+			text:   "0e9000000000000000000 USD",
+			want:   mustParseAmount(t, "0", "USD"),
+		},
+		"lenient grouping of the locale": {
+			locale: "en-IN",
+			text:   "12,34,567.5 INR",
+			want:   mustParseAmount(t, "1234567.5", "INR"),
+		},
+		"strict": {
+			locale:  "de-DE",
+			options: []money.ParseOption{money.WithStrictParsing()},
+			text:    "1.234.567,89 €",
+			want:    mustParseAmount(t, "1234567.89", "EUR"),
+		},
+		"strict ungrouped": {
+			locale:  "en-US",
+			options: []money.ParseOption{money.WithStrictParsing()},
+			text:    "USD 1234.5",
+			want:    mustParseAmount(t, "1234.5", "USD"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			parser, err := money.NewParser(tc.locale, tc.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			got, err := parser.Parse(tc.text)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !got.Equal(tc.want) {
+				t.Errorf("got: %s, want: %s", got.String(), tc.want.String())
+			}
+		})
+	}
+}
+
+func TestParserParse_Errors(t *testing.T) {
+	type testCase struct {
+		locale  string
+		options []money.ParseOption
+		text    string
+		wantErr error
+	}
+
+	strict := []money.ParseOption{money.WithStrictParsing()}
+
+	testCases := map[string]testCase{
+		"missing currency": {
+			locale:  "en-US",
+			text:    "12.34",
+			wantErr: money.ErrMissingCurrency,
+		},
+		"unknown symbol": {
+			locale:  "en-US",
+			text:    "12 ¤¤",
+			wantErr: money.ErrUnknownCurrency,
+		},
+		"ambiguous symbol": {
+			locale:  "it-IT",
+			text:    "¥12",
+			wantErr: money.ErrUnknownCurrency,
+		},
+		"currency given twice": {
+			locale:  "en-US",
+			text:    "$12 USD",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"no digits": {
+			locale:  "en-US",
+			text:    "USD",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"malformed number": {
+			locale:  "en-US",
+			text:    "1.2.3,4.5 USD",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"repeated separator in short groups": {
+			locale:  "en-US",
+			text:    "1.2.3 USD",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"repeated separator in groups of two": {
+			locale:  "en-US",
+			text:    "1.50.00 USD",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"repeated separator with a short last group": {
+			locale:  "en-US",
+			text:    "1.234.56 USD",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"misplaced space grouping": {
+			locale:  "fr-FR",
+			text:    "12 34,5 €",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"too precise": {
+			locale:  "en-US",
+			text:    "1.234 USD",
+			wantErr: money.ErrTooPrecise,
+		},
+		"exponent of the smallest int": {
+			locale:  "en-US",
+			text:    "5e-9223372036854775808 USD",
+			wantErr: money.ErrOverflow,
+		},
+		"large exponent": {
+			locale:  "en-US",
+			text:    "5e19 USD",
+			wantErr: money.ErrOverflow,
+		},
+		"strict misplaced grouping": {
+			locale:  "en-US",
+			options: strict,
+			text:    "$12,34",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"strict foreign decimal separator": {
+			locale:  "de-DE",
+			options: strict,
+			text:    "1234.56 €",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"strict scientific notation": {
+			locale:  "en-US",
+			options: strict,
+			text:    "1e3 USD",
+			wantErr: money.ErrInvalidAmount,
+		},
+		"strict lower case code": {
+			locale:  "en-US",
+			options: strict,
+			text:    "12 usd",
+			wantErr: money.ErrUnknownCurrency,
+		},
+		"strict symbol of another locale": {
+			locale:  "en-US",
+			options: strict,
+			text:    "12 zł",
+			wantErr: money.ErrUnknownCurrency,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			parser, err := money.NewParser(tc.locale, tc.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			_, err = parser.Parse(tc.text)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewParser_UnknownLocale(t *testing.T) {
+	_, err := money.NewParser("xx-XX")
+	if !errors.Is(err, money.ErrUnknownLocale) {
+		t.Errorf("got err: %v, want: %v", err, money.ErrUnknownLocale)
+	}
+}
@@ -0,0 +1,72 @@
+package money
+
+const (
+	// ErrNotPegged is returned when neither currency of a pair has an official peg.
+	ErrNotPegged = Error("currency is not pegged")
+)
+
+// Peg describes an official fixed exchange rate between a currency and its anchor currency.
+type Peg struct {
+	// anchor is the ISO 4217 code of the currency this currency is pegged to.
+	anchor string
+	// rate is the number of units of the pegged currency per one unit of the anchor.
+	rate ExchangeRate
+}
+
+// pegs contains the official pegs known to the package, keyed by the pegged currency code.
+// Rates are expressed as units of the pegged currency per one unit of the anchor.
+var pegs = map[string]Peg{
+	// Euro pegs
+	"BAM": {anchor: "EUR", rate: 1.95583},   // Bosnia and Herzegovina Convertible Mark
+	"BGN": {anchor: "EUR", rate: 1.95583},   // Bulgarian Lev
+	"CVE": {anchor: "EUR", rate: 110.265},   // Cape Verdean Escudo
+	"DKK": {anchor: "EUR", rate: 7.46038},   // Danish Krone, ERM II central rate
+	"KMF": {anchor: "EUR", rate: 491.96775}, // Comorian Franc
+	"STN": {anchor: "EUR", rate: 24.5},      // São Tomé and Príncipe Dobra
+	"XAF": {anchor: "EUR", rate: 655.957},   // Central African CFA Franc
+	"XOF": {anchor: "EUR", rate: 655.957},   // West African CFA Franc
+	"XPF": {anchor: "EUR", rate: 119.33174}, // CFP Franc
+
+	// US Dollar pegs
+	"AED": {anchor: "USD", rate: 3.6725},  // UAE Dirham
+	"AWG": {anchor: "USD", rate: 1.79},    // Aruban Florin
+	"BBD": {anchor: "USD", rate: 2},       // Barbadian Dollar
+	"BHD": {anchor: "USD", rate: 0.376},   // Bahraini Dinar
+	"BMD": {anchor: "USD", rate: 1},       // Bermudian Dollar
+	"BSD": {anchor: "USD", rate: 1},       // Bahamian Dollar
+	"BZD": {anchor: "USD", rate: 2},       // Belize Dollar
+	"DJF": {anchor: "USD", rate: 177.721}, // Djiboutian Franc
+	"JOD": {anchor: "USD", rate: 0.709},   // Jordanian Dinar
+	"OMR": {anchor: "USD", rate: 0.3845},  // Omani Rial
+	"PAB": {anchor: "USD", rate: 1},       // Panamanian Balboa
+	"QAR": {anchor: "USD", rate: 3.64},    // Qatari Riyal
+	"SAR": {anchor: "USD", rate: 3.75},    // Saudi Riyal
+	"XCD": {anchor: "USD", rate: 2.7},     // East Caribbean Dollar
+
+	// Other pegs
+	"BTN": {anchor: "INR", rate: 1}, // Bhutanese Ngultrum
+	"FKP": {anchor: "GBP", rate: 1}, // Falkland Islands Pound
+	"GIP": {anchor: "GBP", rate: 1}, // Gibraltar Pound
+	"LSL": {anchor: "ZAR", rate: 1}, // Lesotho Loti
+	"NAD": {anchor: "ZAR", rate: 1}, // Namibian Dollar
+	"SHP": {anchor: "GBP", rate: 1}, // Saint Helena Pound
+	"SZL": {anchor: "ZAR", rate: 1}, // Swazi Lilangeni
+}
+
+// Peg returns the official peg of the currency, if it has one.
+func (c Currency) Peg() (Peg, bool) {
+	peg, found := pegs[c.code]
+	return peg, found
+}
+
+// Anchor returns the currency the peg is fixed against.
+func (p Peg) Anchor() Currency {
+	// Anchors are known valid currency codes.
+	anchor, _ := ParseCurrency(p.anchor)
+	return anchor
+}
+
+// Rate returns the number of units of the pegged currency per one unit of the anchor.
+func (p Peg) Rate() ExchangeRate {
+	return p.rate
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestCurrencyPeg(t *testing.T) {
+	type testCase struct {
+		code       string
+		wantFound  bool
+		wantAnchor string
+		wantRate   ExchangeRate
+	}
+
+	testCases := map[string]testCase{
+		"West African CFA Franc": {
+			code:       "XOF",
+			wantFound:  true,
+			wantAnchor: "EUR",
+			wantRate:   655.957,
+		},
+		"Saudi Riyal": {
+			code:       "SAR",
+			wantFound:  true,
+			wantAnchor: "USD",
+			wantRate:   3.75,
+		},
+		"floating currency": {
+			code:      "USD",
+			wantFound: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			peg, found := mustCurrency(t, tc.code).Peg()
+			if found != tc.wantFound {
+				t.Fatalf("got found: %t, want: %t", found, tc.wantFound)
+			}
+			if !found {
+				return
+			}
+			if got := peg.Anchor().ISOCode(); got != tc.wantAnchor {
+				t.Errorf("got anchor: %s, want: %s", got, tc.wantAnchor)
+			}
+			if got := peg.Rate(); got != tc.wantRate {
+				t.Errorf("got rate: %g, want: %g", got, tc.wantRate)
+			}
+		})
+	}
+}
+
+func TestPegAnchorsAreValid(t *testing.T) {
+	for code, peg := range pegs {
+		if _, found := pegs[peg.anchor]; found {
+			t.Errorf("%s is pegged to %s which is itself pegged", code, peg.anchor)
+		}
+		if err := validateCurrencyCode(peg.anchor); err != nil {
+			t.Errorf("%s has an invalid anchor %q", code, peg.anchor)
+		}
+	}
+}
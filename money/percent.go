@@ -0,0 +1,112 @@
+package money
+
+import "math/big"
+
+const (
+	// ErrInvalidPeriods is returned when interest is compounded over a negative number of periods.
+	ErrInvalidPeriods = Error("number of periods must not be negative")
+	// ErrInvalidPercentage is returned when a percentage would divide by zero.
+	ErrInvalidPercentage = Error("invalid percentage")
+)
+
+// basisPointsPerUnit is the number of basis points in one, i.e. 100%.
+const basisPointsPerUnit = 10_000
+
+// Percent returns the given percentage of the Amount, e.g. 15% of 80.00 USD is 12.00 USD.
+func (a Amount) Percent(percent Decimal, mode RoundingMode) (Amount, error) {
+	return a.mulRat(big.NewInt(percent.units), percentDenominator(percent), mode)
+}
+
+// BasisPoints returns the given number of basis points (hundredths of a percent) of the Amount,
+// e.g. 25 basis points of 1000.00 USD is 2.50 USD.
+func (a Amount) BasisPoints(basisPoints int64, mode RoundingMode) (Amount, error) {
+	return a.mulRat(big.NewInt(basisPoints), big.NewInt(basisPointsPerUnit), mode)
+}
+
+// Discount returns the Amount reduced by the percentage, e.g. 80.00 USD discounted by 15% is 68.00 USD.
+// The discount itself is rounded, so the result and the discount always sum to the original Amount.
+func (a Amount) Discount(percent Decimal, mode RoundingMode) (Amount, error) {
+	discount, err := a.Percent(percent, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return a.Sub(discount)
+}
+
+// Markup returns the Amount increased by the percentage, e.g. 80.00 USD marked up by 15% is 92.00 USD.
+func (a Amount) Markup(percent Decimal, mode RoundingMode) (Amount, error) {
+	markup, err := a.Percent(percent, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return a.Add(markup)
+}
+
+// AddTax treats the Amount as a net price excluding tax and returns the gross price and the tax at the rate,
+// e.g. 100.00 EUR at 19% is a gross price of 119.00 EUR including 19.00 EUR of tax.
+func (a Amount) AddTax(rate Decimal, mode RoundingMode) (gross Amount, tax Amount, err error) {
+	tax, err = a.Percent(rate, mode)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	gross, err = a.Add(tax)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	return gross, tax, nil
+}
+
+// ExtractTax treats the Amount as a gross price including tax and returns the net price and the tax at the rate,
+// e.g. 119.00 EUR at 19% is a net price of 100.00 EUR and 19.00 EUR of tax.
+// The net price is rounded, so the net price and the tax always sum to the original Amount.
+func (a Amount) ExtractTax(rate Decimal, mode RoundingMode) (net Amount, tax Amount, err error) {
+	// net = gross / (1 + rate / 100) = gross * 100 * 10^p / (100 * 10^p + rate units)
+	denominator := percentDenominator(rate)
+	divisor := new(big.Int).Add(denominator, big.NewInt(rate.units))
+	if divisor.Sign() <= 0 {
+		return Amount{}, Amount{}, ErrInvalidPercentage
+	}
+
+	net, err = a.mulRat(denominator, divisor, mode)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	tax, err = a.Sub(net)
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+
+	return net, tax, nil
+}
+
+// CompoundInterest returns the Amount after interest at the rate percent per period has been compounded
+// for the number of periods, e.g. 1000.00 USD at 5% for 2 periods is 1102.50 USD.
+// The interest is calculated exactly and only the final result is rounded.
+func (a Amount) CompoundInterest(rate Decimal, periods int, mode RoundingMode) (Amount, error) {
+	if periods < 0 {
+		return Amount{}, ErrInvalidPeriods
+	}
+
+	// growth per period = 1 + rate / 100 = (100 * 10^p + rate units) / (100 * 10^p)
+	denominator := percentDenominator(rate)
+	numerator := new(big.Int).Add(denominator, big.NewInt(rate.units))
+	if numerator.Sign() < 0 {
+		return Amount{}, ErrInvalidPercentage
+	}
+
+	exponent := big.NewInt(int64(periods))
+	numerator.Exp(numerator, exponent, nil)
+	denominator.Exp(denominator, exponent, nil)
+
+	return a.mulRat(numerator, denominator, mode)
+}
+
+// percentDenominator returns the denominator that turns the units of a percentage into a fraction, 100 * 10^precision.
+func percentDenominator(percent Decimal) *big.Int {
+	return new(big.Int).Mul(big.NewInt(100), bigPow10(int(percent.precision)))
+}
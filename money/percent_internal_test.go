@@ -0,0 +1,262 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAmountPercent(t *testing.T) {
+	type testCase struct {
+		in      Amount
+		percent Decimal
+		mode    RoundingMode
+		want    Amount
+	}
+
+	testCases := map[string]testCase{
+		"whole percent": {
+			in:      usd(8000),
+			percent: Decimal{units: 15, precision: 0},
+			mode:    RoundHalfEven,
+			want:    usd(1200),
+		},
+		"fractional percent rounded half up": {
+			in:      usd(1999),
+			percent: Decimal{units: 75, precision: 1},
+			mode:    RoundHalfUp,
+			// 7.5% of 19.99 is 1.49925
+			want: usd(150),
+		},
+		"fractional percent rounded down": {
+			in:      usd(1999),
+			percent: Decimal{units: 75, precision: 1},
+			mode:    RoundDown,
+			want:    usd(149),
+		},
+		"negative amount": {
+			in:      usd(-1000),
+			percent: Decimal{units: 25, precision: 0},
+			mode:    RoundHalfEven,
+			want:    usd(-250),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.Percent(tc.percent, tc.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountBasisPoints(t *testing.T) {
+	type testCase struct {
+		in          Amount
+		basisPoints int64
+		mode        RoundingMode
+		want        Amount
+	}
+
+	testCases := map[string]testCase{
+		"25 basis points": {
+			in:          usd(100000),
+			basisPoints: 25,
+			mode:        RoundHalfEven,
+			want:        usd(250),
+		},
+		"rounded half even": {
+			in:          usd(250),
+			basisPoints: 10,
+			mode:        RoundHalfEven,
+			// 0.1% of 2.50 is 0.0025
+			want: usd(0),
+		},
+		"rounded up": {
+			in:          usd(250),
+			basisPoints: 10,
+			mode:        RoundUp,
+			want:        usd(1),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.BasisPoints(tc.basisPoints, tc.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountDiscountMarkup(t *testing.T) {
+	percent := Decimal{units: 15, precision: 0}
+
+	discounted, err := usd(8000).Discount(percent, RoundHalfEven)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if want := usd(6800); !reflect.DeepEqual(discounted, want) {
+		t.Errorf("Discount got: %#v, want: %#v", discounted, want)
+	}
+
+	marked, err := usd(8000).Markup(percent, RoundHalfEven)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if want := usd(9200); !reflect.DeepEqual(marked, want) {
+		t.Errorf("Markup got: %#v, want: %#v", marked, want)
+	}
+}
+
+func TestAmountTax(t *testing.T) {
+	type testCase struct {
+		net   Amount
+		gross Amount
+		tax   Amount
+		rate  Decimal
+		mode  RoundingMode
+	}
+
+	testCases := map[string]testCase{
+		"19 percent": {
+			net:   eur(10000),
+			gross: eur(11900),
+			tax:   eur(1900),
+			rate:  Decimal{units: 19, precision: 0},
+			mode:  RoundHalfEven,
+		},
+		"fractional rate": {
+			net:   eur(1000),
+			gross: eur(1077),
+			tax:   eur(77),
+			rate:  Decimal{units: 77, precision: 1},
+			mode:  RoundHalfEven,
+		},
+		"zero rate": {
+			net:   eur(1234),
+			gross: eur(1234),
+			tax:   eur(0),
+			rate:  Decimal{},
+			mode:  RoundHalfEven,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gross, tax, err := tc.net.AddTax(tc.rate, tc.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(gross, tc.gross) || !reflect.DeepEqual(tax, tc.tax) {
+				t.Errorf("AddTax got: %s and %s, want: %s and %s", &gross, &tax, &tc.gross, &tc.tax)
+			}
+
+			net, tax, err := tc.gross.ExtractTax(tc.rate, tc.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(net, tc.net) || !reflect.DeepEqual(tax, tc.tax) {
+				t.Errorf("ExtractTax got: %s and %s, want: %s and %s", &net, &tax, &tc.net, &tc.tax)
+			}
+		})
+	}
+}
+
+func TestAmountExtractTaxRounding(t *testing.T) {
+	// 10.00 / 1.19 is 8.403361...
+	net, tax, err := eur(1000).ExtractTax(Decimal{units: 19, precision: 0}, RoundHalfEven)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if want := eur(840); !reflect.DeepEqual(net, want) {
+		t.Errorf("got net: %s, want: %s", &net, &want)
+	}
+	if want := eur(160); !reflect.DeepEqual(tax, want) {
+		t.Errorf("got tax: %s, want: %s", &tax, &want)
+	}
+
+	_, _, err = eur(1000).ExtractTax(Decimal{units: -100, precision: 0}, RoundHalfEven)
+	if !errors.Is(err, ErrInvalidPercentage) {
+		t.Errorf("got err: %v, want: %v", err, ErrInvalidPercentage)
+	}
+}
+
+func TestAmountCompoundInterest(t *testing.T) {
+	type testCase struct {
+		in      Amount
+		rate    Decimal
+		periods int
+		mode    RoundingMode
+		want    Amount
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"no periods": {
+			in:      usd(100000),
+			rate:    Decimal{units: 5, precision: 0},
+			periods: 0,
+			mode:    RoundHalfEven,
+			want:    usd(100000),
+		},
+		"two periods": {
+			in:      usd(100000),
+			rate:    Decimal{units: 5, precision: 0},
+			periods: 2,
+			mode:    RoundHalfEven,
+			want:    usd(110250),
+		},
+		"monthly rate for a year is rounded once": {
+			in:      usd(100000),
+			rate:    Decimal{units: 5, precision: 1},
+			periods: 12,
+			mode:    RoundHalfEven,
+			// 1000 * 1.005^12 = 1061.6778...
+			want: usd(106168),
+		},
+		"negative rate": {
+			in:      usd(100000),
+			rate:    Decimal{units: -10, precision: 0},
+			periods: 2,
+			mode:    RoundHalfEven,
+			want:    usd(81000),
+		},
+		"negative periods": {
+			in:      usd(100000),
+			rate:    Decimal{units: 5, precision: 0},
+			periods: -1,
+			want:    Amount{},
+			wantErr: ErrInvalidPeriods,
+		},
+		"rate below -100%": {
+			in:      usd(100000),
+			rate:    Decimal{units: -150, precision: 0},
+			periods: 1,
+			want:    Amount{},
+			wantErr: ErrInvalidPercentage,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.CompoundInterest(tc.rate, tc.periods, tc.mode)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %#v, want: %#v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	// ErrInvalidSpread is returned when a spread is negative or not less than 10000 basis points (100%).
+	ErrInvalidSpread = Error("spread must be between 0 and 9999 basis points")
+	// ErrInvalidFee is returned when a fee is negative or its minimum exceeds its maximum.
+	ErrInvalidFee = Error("fee must not be negative and its minimum must not exceed its maximum")
+	// ErrFeeExceedsAmount is returned when the fee would consume the whole Amount.
+	ErrFeeExceedsAmount = Error("fee exceeds the amount")
+)
+
+// Fee is charged in the currency of the Amount being converted and deducted before conversion.
+type Fee struct {
+	// BasisPoints is the percentage of the Amount charged, in hundredths of a percent.
+	BasisPoints int64
+	// Fixed is added to the percentage.
+	Fixed Decimal
+	// Min and Max bound the fee. A zero Max means the fee is not capped.
+	Min Decimal
+	Max Decimal
+}
+
+// Pricer applies a customer margin to the mid-market rates of another provider.
+// Its rates include the spread, so it can be passed to Convert directly. Fees are not part of a rate,
+// use Quote to apply them.
+//
+// The spread of a pair is the first one configured of the pair's spread, the larger of the spreads of
+// its two currencies, and the default spread.
+type Pricer struct {
+	rates           exchangeRates
+	defaultSpread   int64
+	currencySpreads map[string]int64
+	pairSpreads     map[string]int64
+	defaultFee      Fee
+	fees            map[string]Fee
+}
+
+// PricingOption configures a Pricer.
+type PricingOption func(*Pricer)
+
+// WithDefaultSpread sets the spread in basis points of pairs without a more specific spread.
+func WithDefaultSpread(basisPoints int64) PricingOption {
+	return func(p *Pricer) {
+		p.defaultSpread = basisPoints
+	}
+}
+
+// WithCurrencySpread sets the spread in basis points of every pair the currency is part of.
+func WithCurrencySpread(currency Currency, basisPoints int64) PricingOption {
+	return func(p *Pricer) {
+		p.currencySpreads[currency.code] = basisPoints
+	}
+}
+
+// WithPairSpread sets the spread in basis points of converting from the source to the target currency.
+func WithPairSpread(source, target Currency, basisPoints int64) PricingOption {
+	return func(p *Pricer) {
+		p.pairSpreads[pairKey(source, target)] = basisPoints
+	}
+}
+
+// WithDefaultFee sets the fee of Amounts in currencies without their own fee.
+func WithDefaultFee(fee Fee) PricingOption {
+	return func(p *Pricer) {
+		p.defaultFee = fee
+	}
+}
+
+// WithFee sets the fee of Amounts in the currency. The fixed, minimum and maximum fee are in the currency.
+func WithFee(currency Currency, fee Fee) PricingOption {
+	return func(p *Pricer) {
+		p.fees[currency.code] = fee
+	}
+}
+
+// NewPricer creates a Pricer that applies its spreads and fees to the rates of the provider.
+func NewPricer(rates exchangeRates, options ...PricingOption) (Pricer, error) {
+	pricer := Pricer{
+		rates:           rates,
+		currencySpreads: make(map[string]int64),
+		pairSpreads:     make(map[string]int64),
+		fees:            make(map[string]Fee),
+	}
+
+	for _, option := range options {
+		option(&pricer)
+	}
+
+	spreads := []int64{pricer.defaultSpread}
+	for _, spread := range pricer.currencySpreads {
+		spreads = append(spreads, spread)
+	}
+	for _, spread := range pricer.pairSpreads {
+		spreads = append(spreads, spread)
+	}
+
+	for _, spread := range spreads {
+		if spread < 0 || spread >= basisPointsPerUnit {
+			return Pricer{}, fmt.Errorf("%w: %d", ErrInvalidSpread, spread)
+		}
+	}
+
+	fees := []Fee{pricer.defaultFee}
+	for _, fee := range pricer.fees {
+		fees = append(fees, fee)
+	}
+
+	for _, fee := range fees {
+		if err := fee.validate(); err != nil {
+			return Pricer{}, err
+		}
+	}
+
+	return pricer, nil
+}
+
+// PriceBreakdown shows how a Pricer arrived at the converted Amount.
+type PriceBreakdown struct {
+	// MidRate is the rate of the underlying provider.
+	MidRate ExchangeRate
+	// SpreadBasisPoints is the margin taken from the mid rate.
+	SpreadBasisPoints int64
+	// AppliedRate is the mid rate reduced by the spread.
+	AppliedRate ExchangeRate
+	// Fee is charged in the currency of the Amount and deducted before conversion.
+	Fee Amount
+	// Converted is the Amount less the fee, converted at the applied rate.
+	Converted Amount
+}
+
+// FetchExchangeRate gets the mid rate from the provider, including official pegs, and reduces it by the spread.
+func (p Pricer) FetchExchangeRate(source, target Currency) (ExchangeRate, error) {
+	mid, err := fetchExchangeRate(p.rates, source, target)
+	if err != nil {
+		return 0, err
+	}
+
+	spread := p.spread(source, target)
+	return mid * ExchangeRate(basisPointsPerUnit-spread) / basisPointsPerUnit, nil
+}
+
+// Quote converts the Amount to the target currency after deducting the fee, rounding with the rounding mode.
+// The Amount must be positive.
+func (p Pricer) Quote(amount Amount, to Currency, mode RoundingMode) (PriceBreakdown, error) {
+	if amount.quantity.units <= 0 {
+		return PriceBreakdown{}, fmt.Errorf("%w: %s must be positive", ErrInvalidAmount, amount)
+	}
+
+	mid, err := fetchExchangeRate(p.rates, amount.currency, to)
+	if err != nil {
+		return PriceBreakdown{}, fmt.Errorf("cannot get exchange rate: %w", err)
+	}
+
+	fee, err := p.fee(amount.currency).charge(amount, mode)
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	net, err := amount.Sub(fee)
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	if net.quantity.units <= 0 {
+		return PriceBreakdown{}, fmt.Errorf("%w: %s of %s", ErrFeeExceedsAmount, fee, amount)
+	}
+
+	midDecimal, err := exchangeRateDecimal(mid)
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	spread := p.spread(amount.currency, to)
+
+	// converted units = net units * 10^-net precision * mid * (10000 - spread) / 10000 * 10^target precision
+	numerator := new(big.Int).Mul(big.NewInt(net.quantity.units), big.NewInt(midDecimal.units))
+	numerator.Mul(numerator, big.NewInt(basisPointsPerUnit-spread))
+	numerator.Mul(numerator, bigPow10(int(to.precision)))
+
+	denominator := new(big.Int).Mul(bigPow10(int(net.quantity.precision)), bigPow10(int(midDecimal.precision)))
+	denominator.Mul(denominator, big.NewInt(basisPointsPerUnit))
+
+	units := divRound(numerator, denominator, mode)
+	if !units.IsInt64() {
+		return PriceBreakdown{}, ErrOverflow
+	}
+
+	return PriceBreakdown{
+		MidRate:           mid,
+		SpreadBasisPoints: spread,
+		AppliedRate:       mid * ExchangeRate(basisPointsPerUnit-spread) / basisPointsPerUnit,
+		Fee:               fee,
+		Converted:         AmountFromMinorUnits(units.Int64(), to),
+	}, nil
+}
+
+// spread returns the spread in basis points that applies to the pair.
+func (p Pricer) spread(source, target Currency) int64 {
+	if source.code == target.code {
+		return 0
+	}
+
+	if spread, found := p.pairSpreads[pairKey(source, target)]; found {
+		return spread
+	}
+
+	sourceSpread, sourceFound := p.currencySpreads[source.code]
+	targetSpread, targetFound := p.currencySpreads[target.code]
+	if sourceFound || targetFound {
+		return max(sourceSpread, targetSpread)
+	}
+
+	return p.defaultSpread
+}
+
+// fee returns the fee that applies to Amounts in the currency.
+func (p Pricer) fee(currency Currency) Fee {
+	if fee, found := p.fees[currency.code]; found {
+		return fee
+	}
+
+	return p.defaultFee
+}
+
+// charge calculates the fee for the Amount: the percentage plus the fixed fee, within the bounds.
+func (f Fee) charge(amount Amount, mode RoundingMode) (Amount, error) {
+	fee, err := amount.BasisPoints(f.BasisPoints, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	fixed, err := NewAmount(f.Fixed, amount.currency)
+	if err != nil {
+		return Amount{}, fmt.Errorf("fixed fee %s: %w", f.Fixed, err)
+	}
+
+	fee, err = fee.Add(fixed)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	var bound Decimal
+	switch {
+	case compare(fee.quantity, f.Min) < 0:
+		bound = f.Min
+	case f.Max.units != 0 && compare(fee.quantity, f.Max) > 0:
+		bound = f.Max
+	default:
+		return fee, nil
+	}
+
+	fee, err = NewAmount(bound, amount.currency)
+	if err != nil {
+		return Amount{}, fmt.Errorf("fee bound %s: %w", bound, err)
+	}
+
+	return fee, nil
+}
+
+// validate checks that the fee is not negative and that its bounds are ordered.
+func (f Fee) validate() error {
+	if f.BasisPoints < 0 || f.Fixed.units < 0 || f.Min.units < 0 || f.Max.units < 0 {
+		return ErrInvalidFee
+	}
+
+	if f.Max.units != 0 && compare(f.Min, f.Max) > 0 {
+		return ErrInvalidFee
+	}
+
+	return nil
+}
+
+// pairKey identifies a currency pair, e.g. "USD/EUR".
+func pairKey(source, target Currency) string {
+	return source.code + "/" + target.code
+}
@@ -0,0 +1,200 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPricerConvert(t *testing.T) {
+	pricer, err := NewPricer(fakeRates{"USD/EUR": 0.5}, WithDefaultSpread(100))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	got, err := Convert(usd(10000), mustCurrency(t, "EUR"), pricer)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// 100.00 USD at 0.5 less 1% is 49.50 EUR.
+	if want := eur(4950); !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
+
+func TestPricerSpread(t *testing.T) {
+	usdCurrency, eurCurrency := mustCurrency(t, "USD"), mustCurrency(t, "EUR")
+	gbp, jpy := mustCurrency(t, "GBP"), mustCurrency(t, "JPY")
+
+	pricer, err := NewPricer(fakeRates{},
+		WithDefaultSpread(50),
+		WithCurrencySpread(jpy, 200),
+		WithCurrencySpread(gbp, 75),
+		WithPairSpread(usdCurrency, eurCurrency, 10),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	type testCase struct {
+		source Currency
+		target Currency
+		want   int64
+	}
+
+	testCases := map[string]testCase{
+		"pair":                  {source: usdCurrency, target: eurCurrency, want: 10},
+		"pair is directional":   {source: eurCurrency, target: usdCurrency, want: 50},
+		"source currency":       {source: gbp, target: usdCurrency, want: 75},
+		"target currency":       {source: usdCurrency, target: jpy, want: 200},
+		"larger currency":       {source: gbp, target: jpy, want: 200},
+		"default":               {source: eurCurrency, target: mustCurrency(t, "CHF"), want: 50},
+		"same currency is free": {source: jpy, target: jpy, want: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := pricer.spread(tc.source, tc.target); got != tc.want {
+				t.Errorf("got: %d, want: %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPricerQuote(t *testing.T) {
+	usdCurrency := mustCurrency(t, "USD")
+
+	type testCase struct {
+		options []PricingOption
+		want    PriceBreakdown
+	}
+
+	testCases := map[string]testCase{
+		"spread and fee": {
+			options: []PricingOption{
+				WithDefaultSpread(100),
+				WithFee(usdCurrency, Fee{BasisPoints: 150, Fixed: Decimal{units: 5, precision: 1}}),
+			},
+			// 100.00 - (1.50 + 0.50) = 98.00 USD at 0.5 less 1%
+			want: PriceBreakdown{MidRate: 0.5, SpreadBasisPoints: 100, AppliedRate: 0.495, Fee: usd(200), Converted: eur(4851)},
+		},
+		"minimum fee": {
+			options: []PricingOption{WithFee(usdCurrency, Fee{BasisPoints: 10, Min: Decimal{units: 1}})},
+			want:    PriceBreakdown{MidRate: 0.5, AppliedRate: 0.5, Fee: usd(100), Converted: eur(4950)},
+		},
+		"maximum fee": {
+			options: []PricingOption{WithDefaultFee(Fee{BasisPoints: 500, Max: Decimal{units: 2}})},
+			want:    PriceBreakdown{MidRate: 0.5, AppliedRate: 0.5, Fee: usd(200), Converted: eur(4900)},
+		},
+		"fee of another currency": {
+			options: []PricingOption{WithFee(mustCurrency(t, "EUR"), Fee{Fixed: Decimal{units: 1}})},
+			want:    PriceBreakdown{MidRate: 0.5, AppliedRate: 0.5, Fee: usd(0), Converted: eur(5000)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pricer, err := NewPricer(fakeRates{"USD/EUR": 0.5}, tc.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			got, err := pricer.Quote(usd(10000), mustCurrency(t, "EUR"), RoundHalfEven)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %+v, want: %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPricerQuote_TinyRate(t *testing.T) {
+	// the rate has 254 decimal places, more than a uint8 holds once the cents of the amount are added.
+	pricer, err := NewPricer(fakeRates{"USD/EUR": 1e-254})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	got, err := pricer.Quote(usd(100), mustCurrency(t, "EUR"), RoundHalfEven)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if want := eur(0); !reflect.DeepEqual(got.Converted, want) {
+		t.Errorf("got: %s, want: %s", got.Converted, want)
+	}
+}
+
+func TestPricerQuote_Errors(t *testing.T) {
+	type testCase struct {
+		options []PricingOption
+		amount  Amount
+		target  string
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"fee exceeds amount": {
+			options: []PricingOption{WithDefaultFee(Fee{Fixed: Decimal{units: 5}})},
+			amount:  usd(500),
+			target:  "EUR",
+			wantErr: ErrFeeExceedsAmount,
+		},
+		"zero amount": {
+			amount:  usd(0),
+			target:  "EUR",
+			wantErr: ErrInvalidAmount,
+		},
+		"fixed fee too precise": {
+			options: []PricingOption{WithDefaultFee(Fee{Fixed: Decimal{units: 1, precision: 3}})},
+			amount:  usd(500),
+			target:  "EUR",
+			wantErr: ErrTooPrecise,
+		},
+		"rate not found": {
+			amount:  eur(500),
+			target:  "GBP",
+			wantErr: errFakeRateNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pricer, err := NewPricer(fakeRates{"USD/EUR": 0.5}, tc.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			_, err = pricer.Quote(tc.amount, mustCurrency(t, tc.target), RoundHalfEven)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewPricer_Invalid(t *testing.T) {
+	type testCase struct {
+		option  PricingOption
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"spread of 100%":        {option: WithDefaultSpread(10_000), wantErr: ErrInvalidSpread},
+		"negative spread":       {option: WithPairSpread(mustCurrency(t, "USD"), mustCurrency(t, "EUR"), -1), wantErr: ErrInvalidSpread},
+		"negative fee":          {option: WithDefaultFee(Fee{BasisPoints: -5}), wantErr: ErrInvalidFee},
+		"minimum above maximum": {option: WithFee(mustCurrency(t, "USD"), Fee{Min: Decimal{units: 3}, Max: Decimal{units: 2}}), wantErr: ErrInvalidFee},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewPricer(fakeRates{}, tc.option); !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
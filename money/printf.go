@@ -0,0 +1,110 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format implements the fmt.Formatter interface for Decimal.
+//
+// The verbs %v and %s print the Decimal as String does, %f and %F print it with the precision
+// rounded half to even, e.g. fmt.Sprintf("%.1f", d) of 2.25 is "2.2". Without a precision %f keeps every digit.
+// The '+' flag always prints the sign and the ' ' flag leaves a space for the sign of positive values.
+// A width pads with spaces on the left, or on the right with the '-' flag, or with zeros after the sign
+// with the '0' flag.
+func (d Decimal) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's', 'f', 'F':
+	default:
+		badVerb(s, verb, "Decimal", d)
+		return
+	}
+
+	sign, number := formatDecimal(s, verb, d)
+	pad(s, sign, number, s.Flag('0'))
+}
+
+// Format implements the fmt.Formatter interface for Amount.
+//
+// The verbs %v, %s, %f and %F print the quantity followed by the currency code, e.g. "12.34 USD",
+// and %C prints the currency code first, e.g. "USD 12.34".
+// The quantity is printed as by Decimal.Format, so %.0f of 12.50 USD is "12 USD".
+// A width pads the whole text with spaces, on the right with the '-' flag.
+func (a Amount) Format(s fmt.State, verb rune) {
+	var sign, text string
+
+	switch verb {
+	case 'v', 's', 'f', 'F':
+		var number string
+		sign, number = formatDecimal(s, verb, a.quantity)
+		text = number + " " + a.currency.code
+	case 'C':
+		var number string
+		sign, number = formatDecimal(s, 'f', a.quantity)
+		text = a.currency.code + " " + sign + number
+		sign = ""
+	default:
+		badVerb(s, verb, "Amount", a)
+		return
+	}
+
+	pad(s, sign, text, false)
+}
+
+// formatDecimal returns the sign and digits of the Decimal, rounded to the precision of the %f verb.
+func formatDecimal(s fmt.State, verb rune, d Decimal) (sign, number string) {
+	precision, hasPrecision := s.Precision()
+	if verb != 'f' && verb != 'F' || !hasPrecision {
+		hasPrecision = false
+	}
+
+	if hasPrecision {
+		// precisions beyond what a Decimal can hold only add zeroes.
+		d = d.Round(uint8(min(precision, 255)), RoundHalfEven)
+	}
+
+	number = d.String()
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	} else if s.Flag('+') {
+		sign = "+"
+	} else if s.Flag(' ') {
+		sign = " "
+	}
+
+	if hasPrecision && precision > int(d.precision) {
+		if d.precision == 0 {
+			number += "."
+		}
+		number += strings.Repeat("0", precision-int(d.precision))
+	}
+
+	return sign, number
+}
+
+// pad writes the sign and text padded to the width of the verb.
+// Zero padding is inserted between the sign and the text.
+func pad(s fmt.State, sign, text string, zeroes bool) {
+	width, hasWidth := s.Width()
+	padding := width - utf8.RuneCountInString(sign+text)
+
+	if !hasWidth || padding <= 0 {
+		_, _ = fmt.Fprint(s, sign+text)
+		return
+	}
+
+	switch {
+	case s.Flag('-'):
+		_, _ = fmt.Fprint(s, sign+text+strings.Repeat(" ", padding))
+	case zeroes:
+		_, _ = fmt.Fprint(s, sign+strings.Repeat("0", padding)+text)
+	default:
+		_, _ = fmt.Fprint(s, strings.Repeat(" ", padding)+sign+text)
+	}
+}
+
+// badVerb writes the error fmt prints for an unsupported verb, e.g. "%!d(money.Decimal=12.34)".
+func badVerb(s fmt.State, verb rune, typeName string, value fmt.Stringer) {
+	_, _ = fmt.Fprintf(s, "%%!%c(money.%s=%s)", verb, typeName, value.String())
+}
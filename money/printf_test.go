@@ -0,0 +1,77 @@
+package money_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+func TestDecimalFormat(t *testing.T) {
+	type testCase struct {
+		format string
+		input  money.Decimal
+		want   string
+	}
+
+	testCases := map[string]testCase{
+		"value":                   {format: "%v", input: mustParseDecimal(t, "123.45"), want: "123.45"},
+		"string":                  {format: "%s", input: mustParseDecimal(t, "-0.05"), want: "-0.05"},
+		"plus sign":               {format: "%+v", input: mustParseDecimal(t, "12"), want: "+12"},
+		"plus sign on negative":   {format: "%+v", input: mustParseDecimal(t, "-12"), want: "-12"},
+		"space for sign":          {format: "% v", input: mustParseDecimal(t, "12"), want: " 12"},
+		"fixed without precision": {format: "%f", input: mustParseDecimal(t, "1.23456"), want: "1.23456"},
+		"fixed rounds":            {format: "%.2f", input: mustParseDecimal(t, "1.235"), want: "1.24"},
+		"fixed rounds half even":  {format: "%.1f", input: mustParseDecimal(t, "2.25"), want: "2.2"},
+		"fixed pads zeroes":       {format: "%.3f", input: mustParseDecimal(t, "1.5"), want: "1.500"},
+		"fixed integer":           {format: "%.2f", input: mustParseDecimal(t, "7"), want: "7.00"},
+		"fixed no fraction":       {format: "%.0f", input: mustParseDecimal(t, "-7.5"), want: "-8"},
+		"width":                   {format: "%8v", input: mustParseDecimal(t, "-1.5"), want: "    -1.5"},
+		"left aligned":            {format: "%-8v|", input: mustParseDecimal(t, "1.5"), want: "1.5     |"},
+		"zero padded":             {format: "%08.2f", input: mustParseDecimal(t, "-1.5"), want: "-0001.50"},
+		"narrower than text":      {format: "%2v", input: mustParseDecimal(t, "123.45"), want: "123.45"},
+		"unsupported verb":        {format: "%d", input: mustParseDecimal(t, "1.5"), want: "%!d(money.Decimal=1.5)"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := fmt.Sprintf(tc.format, tc.input); got != tc.want {
+				t.Errorf("got: %q, want: %q", got, tc.want)
+			}
+
+			if got := fmt.Sprintf(tc.format, &tc.input); got != tc.want {
+				t.Errorf("got: %q from pointer, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountFormat(t *testing.T) {
+	type testCase struct {
+		format string
+		input  money.Amount
+		want   string
+	}
+
+	testCases := map[string]testCase{
+		"value":            {format: "%v", input: mustParseAmount(t, "12.3", "USD"), want: "12.30 USD"},
+		"string":           {format: "%s", input: mustParseAmount(t, "-5", "JPY"), want: "-5.00 JPY"},
+		"plus sign":        {format: "%+v", input: mustParseAmount(t, "12.34", "EUR"), want: "+12.34 EUR"},
+		"fixed precision":  {format: "%.0f", input: mustParseAmount(t, "12.50", "USD"), want: "12 USD"},
+		"fixed extends":    {format: "%.4f", input: mustParseAmount(t, "1.5", "KWD"), want: "1.5000 KWD"},
+		"code first":       {format: "%C", input: mustParseAmount(t, "12.34", "USD"), want: "USD 12.34"},
+		"code first sign":  {format: "%+C", input: mustParseAmount(t, "12.34", "USD"), want: "USD +12.34"},
+		"code first round": {format: "%.1C", input: mustParseAmount(t, "-12.35", "USD"), want: "USD -12.4"},
+		"width":            {format: "%12v", input: mustParseAmount(t, "1", "USD"), want: "    1.00 USD"},
+		"left aligned":     {format: "%-12C|", input: mustParseAmount(t, "1", "USD"), want: "USD 1.00    |"},
+		"unsupported verb": {format: "%d", input: mustParseAmount(t, "1", "USD"), want: "%!d(money.Amount=1.00 USD)"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := fmt.Sprintf(tc.format, tc.input); got != tc.want {
+				t.Errorf("got: %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
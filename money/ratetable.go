@@ -0,0 +1,69 @@
+package money
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ErrExchangeRateNotFound is returned when a rate table does not quote the requested currency.
+	ErrExchangeRateNotFound = Error("exchange rate not found")
+)
+
+// RateTable holds exchange rates quoted against a single base currency, as published by most rate feeds.
+// Example: a Euro based table quoting USD at 1.08 means 1 EUR = 1.08 USD.
+type RateTable struct {
+	// Base is the ISO code of the currency all rates are quoted against.
+	Base string
+	// Rates maps ISO codes to the number of units of that currency per one unit of the base.
+	Rates map[string]ExchangeRate
+	// Source names the publisher of the rates, e.g. "ECB", or is empty when unknown.
+	Source string
+	// Date is the day the rates apply to, or the zero time when unknown.
+	Date time.Time
+}
+
+// FetchExchangeRate gets the exchange rate for the source to target currency.
+func (t RateTable) FetchExchangeRate(source, target Currency) (ExchangeRate, error) {
+	if source.code == target.code {
+		return 1., nil
+	}
+
+	sourceFactor, err := t.rate(source.code)
+	if err != nil {
+		return 0, err
+	}
+
+	targetFactor, err := t.rate(target.code)
+	if err != nil {
+		return 0, err
+	}
+
+	// note: 1 / (base -> source) == source -> base
+	// (source -> base) * (base -> target) == target / source
+	return targetFactor / sourceFactor, nil
+}
+
+// FetchQuote gets the exchange rate for the source to target currency together with the source and date of the table.
+func (t RateTable) FetchQuote(source, target Currency) (RateQuote, error) {
+	rate, err := t.FetchExchangeRate(source, target)
+	if err != nil {
+		return RateQuote{}, err
+	}
+
+	return RateQuote{Rate: rate, Source: t.Source, Date: t.Date, Path: []Currency{source, target}}, nil
+}
+
+// rate returns the number of units of the currency per one unit of the base.
+func (t RateTable) rate(code string) (ExchangeRate, error) {
+	if code == t.Base {
+		return 1., nil
+	}
+
+	rate, found := t.Rates[code]
+	if !found || rate <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrExchangeRateNotFound, code)
+	}
+
+	return rate, nil
+}
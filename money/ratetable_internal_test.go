@@ -0,0 +1,75 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRateTableFetchExchangeRate(t *testing.T) {
+	table := RateTable{
+		Base: "EUR",
+		Rates: map[string]ExchangeRate{
+			"USD": 1.08,
+			"CAD": 1.47,
+			"ZZZ": 0,
+		},
+	}
+
+	type testCase struct {
+		source  string
+		target  string
+		want    ExchangeRate
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"same currency": {
+			source: "USD",
+			target: "USD",
+			want:   1,
+		},
+		"from the base": {
+			source: "EUR",
+			target: "USD",
+			want:   1.08,
+		},
+		"to the base": {
+			source: "CAD",
+			target: "EUR",
+			want:   1 / 1.47,
+		},
+		"cross rate": {
+			source: "USD",
+			target: "CAD",
+			want:   1.47 / 1.08,
+		},
+		"missing source": {
+			source:  "GBP",
+			target:  "USD",
+			wantErr: ErrExchangeRateNotFound,
+		},
+		"missing target": {
+			source:  "USD",
+			target:  "GBP",
+			wantErr: ErrExchangeRateNotFound,
+		},
+		"zero rate is treated as missing": {
+			source:  "ZZZ",
+			target:  "USD",
+			wantErr: ErrExchangeRateNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := table.FetchExchangeRate(mustCurrency(t, tc.source), mustCurrency(t, tc.target))
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if math.Abs(float64(got-tc.want)) > 1e-12 {
+				t.Errorf("got: %g, want: %g", got, tc.want)
+			}
+		})
+	}
+}
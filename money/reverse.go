@@ -0,0 +1,76 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	// ErrInvalidExchangeRate is returned when an exchange rate is zero or negative.
+	ErrInvalidExchangeRate = Error("exchange rate must be positive")
+)
+
+// ConvertReverse finds the smallest Amount in the source currency whose conversion to the currency of the target,
+// rounded with the rounding mode, is at least the target. Use RoundDown for the rounding of Convert,
+// e.g. ConvertReverse of 500.00 EUR from USD at a rate of 0.9 is 555.56 USD, which converts to 500.00 EUR.
+// The target must not be negative.
+func ConvertReverse(target Amount, from Currency, rates exchangeRates, mode RoundingMode) (Amount, error) {
+	if target.quantity.units < 0 {
+		return Amount{}, fmt.Errorf("%w: %s must not be negative", ErrInvalidAmount, target)
+	}
+
+	exchangeRate, err := fetchExchangeRate(rates, from, target.currency)
+	if err != nil {
+		return Amount{}, fmt.Errorf("cannot get exchange rate: %w", err)
+	}
+
+	rate, err := exchangeRateDecimal(exchangeRate)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	if rate.units <= 0 {
+		return Amount{}, fmt.Errorf("%w: %s", ErrInvalidExchangeRate, rate)
+	}
+
+	// the forward conversion of x minor units of the source currency to minor units of the target is
+	// round(x * numerator / denominator), the same value convertQuantity computes.
+	numerator := new(big.Int).Mul(big.NewInt(rate.units), bigPow10(int(target.currency.precision)))
+	denominator := bigPow10(int(from.precision) + int(rate.precision))
+
+	forward := func(x *big.Int) *big.Int {
+		return divRound(new(big.Int).Mul(x, numerator), denominator, mode)
+	}
+
+	want := big.NewInt(target.quantity.units)
+
+	// the exact value reaches the target from high, and rounding moves it by less than one unit,
+	// so the smallest source amount lies in [low, high].
+	high := ceilDiv(new(big.Int).Mul(want, denominator), numerator)
+	low := ceilDiv(new(big.Int).Mul(new(big.Int).Sub(want, big.NewInt(1)), denominator), numerator)
+	if low.Sign() < 0 {
+		low.SetInt64(0)
+	}
+
+	for low.Cmp(high) < 0 {
+		middle := new(big.Int).Add(low, high)
+		middle.Rsh(middle, 1)
+
+		if forward(middle).Cmp(want) >= 0 {
+			high = middle
+		} else {
+			low = middle.Add(middle, big.NewInt(1))
+		}
+	}
+
+	if !high.IsInt64() {
+		return Amount{}, ErrOverflow
+	}
+
+	return AmountFromMinorUnits(high.Int64(), from), nil
+}
+
+// ceilDiv returns n / d rounded towards positive infinity for a positive divisor.
+func ceilDiv(n, d *big.Int) *big.Int {
+	return divRound(n, d, RoundCeiling)
+}
@@ -0,0 +1,164 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestConvertReverse(t *testing.T) {
+	krw := mustCurrency(t, "KRW")
+
+	type testCase struct {
+		target Amount
+		from   Currency
+		rates  fakeRates
+		mode   RoundingMode
+		want   Amount
+	}
+
+	testCases := map[string]testCase{
+		"truncating like Convert": {
+			target: eur(50000),
+			from:   mustCurrency(t, "USD"),
+			rates:  fakeRates{"USD/EUR": 0.9},
+			mode:   RoundDown,
+			// 555.55 USD is 499.995 EUR, which truncates to 499.99 EUR.
+			want: usd(55556),
+		},
+		"rounding half up reaches the target from below": {
+			target: eur(50000),
+			from:   mustCurrency(t, "USD"),
+			rates:  fakeRates{"USD/EUR": 0.9},
+			mode:   RoundHalfUp,
+			// 555.55 USD is 499.995 EUR, which rounds up to 500.00 EUR.
+			want: usd(55555),
+		},
+		"exact": {
+			target: eur(1000),
+			from:   mustCurrency(t, "USD"),
+			rates:  fakeRates{"USD/EUR": 0.5},
+			mode:   RoundDown,
+			want:   usd(2000),
+		},
+		"rounding half even below the exact amount": {
+			target: eur(1000),
+			from:   mustCurrency(t, "USD"),
+			rates:  fakeRates{"USD/EUR": 0.5},
+			mode:   RoundHalfEven,
+			// 19.99 USD is 9.995 EUR, which rounds to the even 10.00 EUR.
+			want: usd(1999),
+		},
+		"source with more value per unit": {
+			target: AmountFromMinorUnits(10_000_000, krw),
+			from:   mustCurrency(t, "USD"),
+			rates:  fakeRates{"USD/KRW": 1375.5},
+			mode:   RoundDown,
+			want:   usd(7271),
+		},
+		"zero": {
+			target: eur(0),
+			from:   mustCurrency(t, "USD"),
+			rates:  fakeRates{"USD/EUR": 0.9},
+			mode:   RoundDown,
+			want:   usd(0),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ConvertReverse(tc.target, tc.from, tc.rates, tc.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertReverse_IsMinimal(t *testing.T) {
+	usdCurrency := mustCurrency(t, "USD")
+	rates := []ExchangeRate{0.9, 1.37, 0.0067, 123.45, 1}
+	modes := []RoundingMode{RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+
+	for _, rate := range rates {
+		for _, mode := range modes {
+			for _, cents := range []int64{1, 99, 50000, 123457} {
+				target := eur(cents)
+				provider := fakeRates{"USD/EUR": rate}
+
+				got, err := ConvertReverse(target, usdCurrency, provider, mode)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				forward, err := ConvertDetailed(got, target.currency, provider, mode)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				if c, _ := forward.Result.Cmp(target); c < 0 {
+					t.Errorf("rate %g %s: %s converts to %s, want at least %s", rate, mode, got, forward.Result, target)
+				}
+
+				if got.IsZero() {
+					continue
+				}
+
+				less := AmountFromMinorUnits(got.MinorUnits()-1, usdCurrency)
+				smaller, err := ConvertDetailed(less, target.currency, provider, mode)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				if c, _ := smaller.Result.Cmp(target); c >= 0 {
+					t.Errorf("rate %g %s: %s is not minimal, %s converts to %s", rate, mode, got, less, smaller.Result)
+				}
+			}
+		}
+	}
+}
+
+func TestConvertReverse_Errors(t *testing.T) {
+	type testCase struct {
+		target  Amount
+		rates   fakeRates
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"negative target": {
+			target:  eur(-100),
+			rates:   fakeRates{"USD/EUR": 0.9},
+			wantErr: ErrInvalidAmount,
+		},
+		"zero rate": {
+			target:  eur(100),
+			rates:   fakeRates{"USD/EUR": 0},
+			wantErr: ErrInvalidExchangeRate,
+		},
+		"rate too small for the source amount": {
+			target: eur(100),
+			// the rate has 254 decimal places, more than a uint8 holds once the cents of the source are added.
+			rates:   fakeRates{"USD/EUR": 1e-254},
+			wantErr: ErrOverflow,
+		},
+		"rate not found": {
+			target:  eur(100),
+			rates:   fakeRates{},
+			wantErr: errFakeRateNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConvertReverse(tc.target, mustCurrency(t, "USD"), tc.rates, RoundDown)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	// ErrUnknownRoundingMode is returned when a rounding mode is not one of the defined modes.
+	ErrUnknownRoundingMode = Error("unknown rounding mode")
+)
+
+// RoundingMode determines how a value is rounded when digits have to be dropped.
+type RoundingMode uint8
+
+const (
+	// RoundHalfEven rounds to the nearest value, ties towards the even neighbour (banker's rounding).
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, ties away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value, ties towards zero.
+	RoundHalfDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero, truncating the dropped digits.
+	RoundDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+// String implements the Stringer interface.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "half-even"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundHalfDown:
+		return "half-down"
+	case RoundUp:
+		return "up"
+	case RoundDown:
+		return "down"
+	case RoundCeiling:
+		return "ceiling"
+	case RoundFloor:
+		return "floor"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, e.g. "half-even".
+func (m RoundingMode) MarshalText() ([]byte, error) {
+	if m > RoundFloor {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownRoundingMode, m)
+	}
+
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, reading the names written by MarshalText.
+func (m *RoundingMode) UnmarshalText(text []byte) error {
+	for mode := RoundHalfEven; mode <= RoundFloor; mode++ {
+		if mode.String() == string(text) {
+			*m = mode
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q", ErrUnknownRoundingMode, text)
+}
+
+// Round returns the Decimal rounded to at most scale decimal places using the rounding mode.
+func (d Decimal) Round(scale uint8, mode RoundingMode) Decimal {
+	if d.precision <= scale {
+		return d
+	}
+
+	units := divRound(big.NewInt(d.units), bigPow10(int(d.precision-scale)), mode)
+
+	// Dropping at least one digit always brings the units back into range.
+	rounded := Decimal{units: units.Int64(), precision: scale}
+	rounded.simplify()
+	return rounded
+}
+
+// divRound divides n by the positive divisor d and rounds the quotient using the rounding mode.
+func divRound(n, d *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(n, d, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	var awayFromZero bool
+
+	switch mode {
+	case RoundUp:
+		awayFromZero = true
+	case RoundDown:
+		awayFromZero = false
+	case RoundCeiling:
+		awayFromZero = n.Sign() > 0
+	case RoundFloor:
+		awayFromZero = n.Sign() < 0
+	default:
+		// compare the dropped part with one half: 2 * |remainder| against the divisor.
+		twice := new(big.Int).Abs(remainder)
+		twice.Lsh(twice, 1)
+
+		switch twice.Cmp(d) {
+		case 1:
+			awayFromZero = true
+		case -1:
+			awayFromZero = false
+		default:
+			awayFromZero = mode == RoundHalfUp || (mode == RoundHalfEven && quotient.Bit(0) == 1)
+		}
+	}
+
+	if awayFromZero {
+		quotient.Add(quotient, big.NewInt(int64(n.Sign())))
+	}
+
+	return quotient
+}
@@ -0,0 +1,127 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecimalRound(t *testing.T) {
+	type testCase struct {
+		input Decimal
+		scale uint8
+		want  map[RoundingMode]Decimal
+	}
+
+	testCases := map[string]testCase{
+		"exact value is unchanged": {
+			input: Decimal{units: 125, precision: 2},
+			scale: 2,
+			want: map[RoundingMode]Decimal{
+				RoundHalfEven: {units: 125, precision: 2},
+				RoundDown:     {units: 125, precision: 2},
+			},
+		},
+		"positive tie": {
+			input: Decimal{units: 125, precision: 2},
+			scale: 1,
+			want: map[RoundingMode]Decimal{
+				RoundHalfEven: {units: 12, precision: 1},
+				RoundHalfUp:   {units: 13, precision: 1},
+				RoundHalfDown: {units: 12, precision: 1},
+				RoundUp:       {units: 13, precision: 1},
+				RoundDown:     {units: 12, precision: 1},
+				RoundCeiling:  {units: 13, precision: 1},
+				RoundFloor:    {units: 12, precision: 1},
+			},
+		},
+		"negative tie": {
+			input: Decimal{units: -135, precision: 2},
+			scale: 1,
+			want: map[RoundingMode]Decimal{
+				RoundHalfEven: {units: -14, precision: 1},
+				RoundHalfUp:   {units: -14, precision: 1},
+				RoundHalfDown: {units: -13, precision: 1},
+				RoundUp:       {units: -14, precision: 1},
+				RoundDown:     {units: -13, precision: 1},
+				RoundCeiling:  {units: -13, precision: 1},
+				RoundFloor:    {units: -14, precision: 1},
+			},
+		},
+		"below half": {
+			input: Decimal{units: 1249, precision: 3},
+			scale: 1,
+			want: map[RoundingMode]Decimal{
+				RoundHalfEven: {units: 12, precision: 1},
+				RoundHalfUp:   {units: 12, precision: 1},
+				RoundUp:       {units: 13, precision: 1},
+				RoundFloor:    {units: 12, precision: 1},
+			},
+		},
+		"above half": {
+			input: Decimal{units: -1251, precision: 3},
+			scale: 1,
+			want: map[RoundingMode]Decimal{
+				RoundHalfEven: {units: -13, precision: 1},
+				RoundHalfDown: {units: -13, precision: 1},
+				RoundDown:     {units: -12, precision: 1},
+				RoundCeiling:  {units: -12, precision: 1},
+			},
+		},
+		"rounded result is simplified": {
+			input: Decimal{units: 1996, precision: 3},
+			scale: 2,
+			want: map[RoundingMode]Decimal{
+				RoundHalfEven: {units: 2, precision: 0},
+				RoundDown:     {units: 199, precision: 2},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for mode, want := range tc.want {
+				got := tc.input.Round(tc.scale, mode)
+				if !decimalEqual(got, want) {
+					t.Errorf("%s: got: %#v, want: %#v", mode, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	if got := RoundHalfEven.String(); got != "half-even" {
+		t.Errorf("got: %s, want: half-even", got)
+	}
+
+	if got := RoundingMode(99).String(); got != "unknown" {
+		t.Errorf("got: %s, want: unknown", got)
+	}
+}
+
+func TestRoundingModeText(t *testing.T) {
+	for mode := RoundHalfEven; mode <= RoundFloor; mode++ {
+		text, err := mode.MarshalText()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		var got RoundingMode
+		if err := got.UnmarshalText(text); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		if got != mode {
+			t.Errorf("got: %s, want: %s", got, mode)
+		}
+	}
+
+	if _, err := RoundingMode(99).MarshalText(); !errors.Is(err, ErrUnknownRoundingMode) {
+		t.Errorf("got err: %v, want: %v", err, ErrUnknownRoundingMode)
+	}
+
+	var got RoundingMode
+	if err := got.UnmarshalText([]byte("nearest")); !errors.Is(err, ErrUnknownRoundingMode) {
+		t.Errorf("got err: %v, want: %v", err, ErrUnknownRoundingMode)
+	}
+}
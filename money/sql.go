@@ -0,0 +1,91 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+const (
+	// ErrUnsupportedScan is returned when a database value cannot be scanned into a money type.
+	ErrUnsupportedScan = Error("unsupported database value")
+)
+
+// Value implements the driver.Valuer interface, storing the Decimal as text so that NUMERIC and TEXT
+// columns receive it without loss, e.g. "123.45".
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements the sql.Scanner interface, reading NUMERIC and TEXT columns as well as integers.
+// Floating point values are rejected as they may already have lost precision.
+func (d *Decimal) Scan(src any) error {
+	switch value := src.(type) {
+	case int64:
+		*d = NewDecimal(value, 0)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(value))
+	case []byte:
+		return d.UnmarshalText(value)
+	default:
+		return fmt.Errorf("%w: %T into Decimal", ErrUnsupportedScan, src)
+	}
+}
+
+// Value implements the driver.Valuer interface, storing the ISO code, e.g. in a CHAR(3) column.
+func (c Currency) Value() (driver.Value, error) {
+	return c.code, nil
+}
+
+// Scan implements the sql.Scanner interface, reading an ISO code. Padding of CHAR columns is ignored.
+func (c *Currency) Scan(src any) error {
+	switch value := src.(type) {
+	case string:
+		return c.UnmarshalText([]byte(strings.TrimRight(value, " ")))
+	case []byte:
+		return c.UnmarshalText([]byte(strings.TrimRight(string(value), " ")))
+	default:
+		return fmt.Errorf("%w: %T into Currency", ErrUnsupportedScan, src)
+	}
+}
+
+// Value implements the driver.Valuer interface, storing the Amount in a single text column as
+// MarshalText does, e.g. "12.34 USD". Use AmountColumns to store the quantity and currency separately.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan implements the sql.Scanner interface, reading the text stored by Value.
+func (a *Amount) Scan(src any) error {
+	switch value := src.(type) {
+	case string:
+		return a.UnmarshalText([]byte(value))
+	case []byte:
+		return a.UnmarshalText(value)
+	default:
+		return fmt.Errorf("%w: %T into Amount", ErrUnsupportedScan, src)
+	}
+}
+
+// AmountColumns stores an Amount in two columns, a NUMERIC or TEXT quantity and a CHAR(3) currency.
+// Example:
+//
+//	columns := money.NewAmountColumns(price)
+//	db.Exec("INSERT INTO prices (quantity, currency) VALUES ($1, $2)", columns.Quantity, columns.Currency)
+//	row.Scan(&columns.Quantity, &columns.Currency)
+//	price, err := columns.Amount()
+type AmountColumns struct {
+	Quantity Decimal
+	Currency Currency
+}
+
+// NewAmountColumns splits the Amount into its columns.
+func NewAmountColumns(a Amount) AmountColumns {
+	return AmountColumns{Quantity: a.quantity, Currency: a.currency}
+}
+
+// Amount combines the scanned columns into an Amount, see NewAmount.
+func (c AmountColumns) Amount() (Amount, error) {
+	return NewAmount(c.Quantity, c.Currency)
+}
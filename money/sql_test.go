@@ -0,0 +1,201 @@
+package money_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+// fakeDatabase is an in-process database/sql driver holding a single table:
+// every Exec appends its arguments as a row and every Query returns all rows.
+type fakeDatabase struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+func (db *fakeDatabase) Connect(context.Context) (driver.Conn, error) { return fakeConn{db}, nil }
+func (db *fakeDatabase) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ db *fakeDatabase }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt(c), nil }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct{ db *fakeDatabase }
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	s.db.rows = append(s.db.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	return &fakeRows{rows: append([][]driver.Value(nil), s.db.rows...)}, nil
+}
+
+type fakeRows struct{ rows [][]driver.Value }
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+
+	columns := make([]string, len(r.rows[0]))
+	for i := range columns {
+		columns[i] = "c" + strconv.Itoa(i)
+	}
+	return columns
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	db := sql.OpenDB(&fakeDatabase{})
+	defer db.Close()
+
+	price := mustParseAmount(t, "-1234.5", "EUR")
+	columns := money.NewAmountColumns(price)
+
+	_, err := db.Exec("INSERT", price, columns.Quantity, columns.Currency, mustParseDecimal(t, "0.000123"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	var (
+		got     money.Amount
+		scanned money.AmountColumns
+		rate    money.Decimal
+	)
+
+	row := db.QueryRow("SELECT")
+	if err := row.Scan(&got, &scanned.Quantity, &scanned.Currency, &rate); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !got.Equal(price) {
+		t.Errorf("got: %s, want: %s", got, price)
+	}
+
+	fromColumns, err := scanned.Amount()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !fromColumns.Equal(price) {
+		t.Errorf("got: %s from columns, want: %s", fromColumns, price)
+	}
+
+	if want := mustParseDecimal(t, "0.000123"); rate != want {
+		t.Errorf("got: %s, want: %s", rate, want)
+	}
+}
+
+func TestDecimalScan(t *testing.T) {
+	type testCase struct {
+		src     any
+		want    money.Decimal
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"numeric bytes":   {src: []byte("12.30"), want: mustParseDecimal(t, "12.3")},
+		"text":            {src: "-0.5", want: mustParseDecimal(t, "-0.5")},
+		"integer":         {src: int64(42), want: mustParseDecimal(t, "42")},
+		"float":           {src: 1.5, wantErr: money.ErrUnsupportedScan},
+		"null":            {src: nil, wantErr: money.ErrUnsupportedScan},
+		"malformed value": {src: "12,5", wantErr: money.ErrInvalidDecimal},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got money.Decimal
+			err := got.Scan(tc.src)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+
+			if got != tc.want {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyScan(t *testing.T) {
+	type testCase struct {
+		src     any
+		want    string
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"char":        {src: []byte("USD"), want: "USD"},
+		"padded char": {src: "EUR ", want: "EUR"},
+		"invalid":     {src: "usd", wantErr: money.ErrInvalidCurrencyCode},
+		"integer":     {src: int64(840), wantErr: money.ErrUnsupportedScan},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got money.Currency
+			err := got.Scan(tc.src)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+
+			if got.String() != tc.want {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAmountScan_Errors(t *testing.T) {
+	type testCase struct {
+		src     any
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"null":             {src: nil, wantErr: money.ErrUnsupportedScan},
+		"missing currency": {src: "12.34", wantErr: money.ErrInvalidAmount},
+		"too precise":      {src: []byte("12.345 USD"), wantErr: money.ErrTooPrecise},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got money.Amount
+			if err := got.Scan(tc.src); !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
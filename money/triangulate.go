@@ -0,0 +1,178 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// ErrNoConversionPath is returned when no combination of quotes connects the source and target currencies.
+	ErrNoConversionPath = Error("no conversion path found")
+)
+
+// CrossRate is an exchange rate together with the path of currencies it was derived through.
+type CrossRate struct {
+	// Rate is the product of the rates along the path.
+	Rate ExchangeRate
+	// Path lists the currencies from the source to the target. A direct quote has a path of length 2.
+	Path []Currency
+}
+
+// String implements the Stringer interface, e.g. "MXN -> USD -> SEK".
+func (c CrossRate) String() string {
+	codes := make([]string, 0, len(c.Path))
+	for _, currency := range c.Path {
+		codes = append(codes, currency.code)
+	}
+
+	return strings.Join(codes, " -> ")
+}
+
+// Triangulator finds exchange rates by combining the direct quotes of one or more providers.
+// When no provider quotes a pair directly, the rate is derived through the pivot currencies,
+// preferring the shortest path and, among paths of equal length, the pivots in the order given.
+type Triangulator struct {
+	pivots    []Currency
+	providers []exchangeRates
+}
+
+// NewTriangulator creates a Triangulator that routes through the pivots and asks the providers
+// for direct quotes in the order given.
+func NewTriangulator(pivots []Currency, providers ...exchangeRates) Triangulator {
+	return Triangulator{pivots: pivots, providers: providers}
+}
+
+// FetchExchangeRate gets the exchange rate for the source to target currency.
+func (t Triangulator) FetchExchangeRate(source, target Currency) (ExchangeRate, error) {
+	cross, err := t.CrossRate(source, target)
+	if err != nil {
+		return 0, err
+	}
+
+	return cross.Rate, nil
+}
+
+// FetchQuote gets the exchange rate for the source to target currency and reports the path used.
+// The source and date are those of the quotes along the path when they all agree, and are left empty otherwise.
+func (t Triangulator) FetchQuote(source, target Currency) (RateQuote, error) {
+	return t.search(source, target)
+}
+
+// CrossRate finds the exchange rate for the source to target currency and reports the path used.
+// Providers that implement FetchRateTable() (RateTable, error) are asked for their table once per call.
+// When no path is found the error wraps ErrNoConversionPath and the last error of the providers.
+func (t Triangulator) CrossRate(source, target Currency) (CrossRate, error) {
+	quote, err := t.search(source, target)
+	if err != nil {
+		return CrossRate{}, err
+	}
+
+	return CrossRate{Rate: quote.Rate, Path: quote.Path}, nil
+}
+
+// search finds the shortest path of direct quotes from the source to the target currency.
+func (t Triangulator) search(source, target Currency) (RateQuote, error) {
+	if source.code == target.code {
+		return RateQuote{Rate: 1, Path: []Currency{source}}, nil
+	}
+
+	// providers that can return all of their rates at once are asked a single time,
+	// as the search below looks up many pairs.
+	providers := make([]exchangeRates, 0, len(t.providers))
+
+	// lastErr keeps the cause of the most recent failed lookup to report when no path is found.
+	var lastErr error
+	for _, provider := range t.providers {
+		rates, err := batched(provider)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		providers = append(providers, rates)
+	}
+
+	if len(providers) == 0 {
+		return RateQuote{}, noConversionPath(source, target, lastErr)
+	}
+
+	// candidates are tried in order from each currency on the path,
+	// the target first so that a direct quote always wins.
+	candidates := append([]Currency{target}, t.pivots...)
+
+	visited := map[string]bool{source.code: true}
+	queue := []RateQuote{{Rate: 1, Path: []Currency{source}}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		last := current.Path[len(current.Path)-1]
+
+		for _, next := range candidates {
+			if visited[next.code] {
+				continue
+			}
+
+			hop, err := quote(providers, last, next)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+
+			step := extend(current, hop, next)
+			if next.code == target.code {
+				return step, nil
+			}
+
+			visited[next.code] = true
+			queue = append(queue, step)
+		}
+	}
+
+	return RateQuote{}, noConversionPath(source, target, lastErr)
+}
+
+// extend appends a hop to the next currency to the quote, keeping the source and date only while every hop agrees.
+func extend(current, hop RateQuote, next Currency) RateQuote {
+	path := make([]Currency, len(current.Path), len(current.Path)+1)
+	copy(path, current.Path)
+
+	step := RateQuote{Rate: current.Rate * hop.Rate, Source: hop.Source, Date: hop.Date, Path: append(path, next)}
+
+	// the first hop starts from a path holding only the source currency.
+	if len(current.Path) > 1 {
+		if current.Source != hop.Source {
+			step.Source = ""
+		}
+		if !current.Date.Equal(hop.Date) {
+			step.Date = time.Time{}
+		}
+	}
+
+	return step
+}
+
+// noConversionPath returns ErrNoConversionPath for the pair, wrapping the cause when there is one.
+func noConversionPath(source, target Currency, cause error) error {
+	if cause == nil {
+		return fmt.Errorf("%w: %s to %s", ErrNoConversionPath, source, target)
+	}
+
+	return fmt.Errorf("%w: %s to %s: %w", ErrNoConversionPath, source, target, cause)
+}
+
+// quote returns the first direct quote for the pair offered by the providers,
+// or the error of the last provider when none offers it. There must be at least one provider.
+func quote(providers []exchangeRates, source, target Currency) (RateQuote, error) {
+	var err error
+	for _, provider := range providers {
+		var quote RateQuote
+		quote, err = directQuote(provider, source, target)
+		if err == nil {
+			return quote, nil
+		}
+	}
+
+	return RateQuote{}, err
+}
@@ -0,0 +1,254 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTriangulatorCrossRate(t *testing.T) {
+	// usdFeed quotes against the US Dollar and ecbFeed against the Euro.
+	usdFeed := fakeRates{
+		"USD/CAD": 1.37,
+		"USD/MXN": 17.0,
+		"MXN/USD": 1 / 17.0,
+	}
+	ecbFeed := fakeRates{
+		"EUR/USD": 1.08,
+		"USD/EUR": 1 / 1.08,
+		"EUR/SEK": 11.5,
+		"USD/SEK": 11.5 / 1.08,
+	}
+
+	type testCase struct {
+		pivots   []string
+		source   string
+		target   string
+		want     ExchangeRate
+		wantPath string
+		wantErr  error
+	}
+
+	testCases := map[string]testCase{
+		"same currency": {
+			pivots:   []string{"USD", "EUR"},
+			source:   "CAD",
+			target:   "CAD",
+			want:     1,
+			wantPath: "CAD",
+		},
+		"direct quote is preferred": {
+			pivots:   []string{"USD", "EUR"},
+			source:   "USD",
+			target:   "CAD",
+			want:     1.37,
+			wantPath: "USD -> CAD",
+		},
+		"direct quote from the second provider": {
+			pivots:   []string{"USD", "EUR"},
+			source:   "EUR",
+			target:   "SEK",
+			want:     11.5,
+			wantPath: "EUR -> SEK",
+		},
+		"single pivot across providers": {
+			pivots:   []string{"USD", "EUR"},
+			source:   "MXN",
+			target:   "SEK",
+			want:     1 / 17.0 * 11.5 / 1.08,
+			wantPath: "MXN -> USD -> SEK",
+		},
+		"target is also a pivot": {
+			pivots:   []string{"USD", "EUR"},
+			source:   "MXN",
+			target:   "EUR",
+			want:     1 / 17.0 / 1.08,
+			wantPath: "MXN -> USD -> EUR",
+		},
+		"without a usable pivot": {
+			pivots:  []string{"EUR"},
+			source:  "MXN",
+			target:  "SEK",
+			wantErr: ErrNoConversionPath,
+		},
+		"no pivots": {
+			source:  "CAD",
+			target:  "SEK",
+			wantErr: ErrNoConversionPath,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pivots := make([]Currency, 0, len(tc.pivots))
+			for _, code := range tc.pivots {
+				pivots = append(pivots, mustCurrency(t, code))
+			}
+
+			triangulator := NewTriangulator(pivots, usdFeed, ecbFeed)
+			got, err := triangulator.CrossRate(mustCurrency(t, tc.source), mustCurrency(t, tc.target))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got err: %v, want: %v", err, tc.wantErr)
+			}
+			if math.Abs(float64(got.Rate-tc.want)) > 1e-12 {
+				t.Errorf("got rate: %g, want: %g", got.Rate, tc.want)
+			}
+			if got.String() != tc.wantPath {
+				t.Errorf("got path: %q, want: %q", got.String(), tc.wantPath)
+			}
+		})
+	}
+}
+
+func TestTriangulatorFetchExchangeRate(t *testing.T) {
+	triangulator := NewTriangulator(
+		[]Currency{mustCurrency(t, "USD")},
+		fakeRates{"CAD/USD": 0.73},
+		fakeRates{"USD/JPY": 150},
+	)
+
+	got, err := triangulator.FetchExchangeRate(mustCurrency(t, "CAD"), mustCurrency(t, "JPY"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if want := ExchangeRate(0.73 * 150); math.Abs(float64(got-want)) > 1e-9 {
+		t.Errorf("got: %g, want: %g", got, want)
+	}
+}
+
+// failingTable is a provider whose every call fails, like a feed that cannot be reached.
+type failingTable struct {
+	err error
+}
+
+// FetchExchangeRate implements the exchangeRates interface.
+func (f failingTable) FetchExchangeRate(Currency, Currency) (ExchangeRate, error) {
+	return 0, f.err
+}
+
+// FetchRateTable implements the rateTableProvider interface.
+func (f failingTable) FetchRateTable() (RateTable, error) {
+	return RateTable{}, f.err
+}
+
+func TestTriangulatorCrossRate_ProviderError(t *testing.T) {
+	errUnavailable := errors.New("feed unavailable")
+
+	type testCase struct {
+		providers []exchangeRates
+		wantErr   error
+	}
+
+	testCases := map[string]testCase{
+		"rate table cannot be fetched": {
+			providers: []exchangeRates{failingTable{err: errUnavailable}},
+			wantErr:   errUnavailable,
+		},
+		"rate is not quoted": {
+			providers: []exchangeRates{fakeRates{"CAD/USD": 0.73}},
+			wantErr:   errFakeRateNotFound,
+		},
+		"no providers": {
+			wantErr: ErrNoConversionPath,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			triangulator := NewTriangulator([]Currency{mustCurrency(t, "USD")}, tc.providers...)
+
+			_, err := triangulator.CrossRate(mustCurrency(t, "CAD"), mustCurrency(t, "JPY"))
+			if !errors.Is(err, ErrNoConversionPath) {
+				t.Errorf("got err: %v, want: %v", err, ErrNoConversionPath)
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got err: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestTriangulatorCrossRate_FetchesRateTableOnce(t *testing.T) {
+	table := &countingTable{table: RateTable{
+		Base:  "USD",
+		Rates: map[string]ExchangeRate{"CAD": 1.37, "EUR": 0.92},
+	}}
+
+	triangulator := NewTriangulator(
+		[]Currency{mustCurrency(t, "EUR"), mustCurrency(t, "USD")},
+		table,
+		fakeRates{"USD/JPY": 150},
+	)
+
+	got, err := triangulator.CrossRate(mustCurrency(t, "CAD"), mustCurrency(t, "JPY"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if want := "CAD -> USD -> JPY"; got.String() != want {
+		t.Errorf("got path: %s, want: %s", got, want)
+	}
+
+	if table.tableCalls != 1 || table.rateCalls != 0 {
+		t.Errorf("got %d table and %d rate calls, want 1 and 0", table.tableCalls, table.rateCalls)
+	}
+}
+
+func TestTriangulatorFetchQuote_SourceAndDate(t *testing.T) {
+	friday := time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC)
+	thursday := friday.AddDate(0, 0, -1)
+
+	type testCase struct {
+		providers  []exchangeRates
+		wantSource string
+		wantDate   time.Time
+	}
+
+	testCases := map[string]testCase{
+		"every hop agrees": {
+			providers: []exchangeRates{
+				RateTable{Base: "EUR", Rates: map[string]ExchangeRate{"CAD": 1.47, "USD": 1.08}, Source: "ECB", Date: friday},
+				RateTable{Base: "USD", Rates: map[string]ExchangeRate{"JPY": 155}, Source: "ECB", Date: friday},
+			},
+			wantSource: "ECB",
+			wantDate:   friday,
+		},
+		"hops of different days": {
+			providers: []exchangeRates{
+				RateTable{Base: "EUR", Rates: map[string]ExchangeRate{"CAD": 1.47, "USD": 1.08}, Source: "ECB", Date: friday},
+				RateTable{Base: "USD", Rates: map[string]ExchangeRate{"JPY": 155}, Source: "ECB", Date: thursday},
+			},
+			wantSource: "ECB",
+		},
+		"hops of different sources": {
+			providers: []exchangeRates{
+				RateTable{Base: "EUR", Rates: map[string]ExchangeRate{"CAD": 1.47, "USD": 1.08}, Source: "ECB", Date: friday},
+				RateTable{Base: "USD", Rates: map[string]ExchangeRate{"JPY": 155}, Source: "BoC", Date: friday},
+			},
+			wantDate: friday,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			triangulator := NewTriangulator([]Currency{mustCurrency(t, "USD")}, tc.providers...)
+
+			got, err := triangulator.FetchQuote(mustCurrency(t, "CAD"), mustCurrency(t, "JPY"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(got.Path) != 3 {
+				t.Fatalf("got path: %v, want a path through USD", got.Path)
+			}
+			if got.Source != tc.wantSource {
+				t.Errorf("got source: %q, want: %q", got.Source, tc.wantSource)
+			}
+			if !got.Date.Equal(tc.wantDate) {
+				t.Errorf("got date: %s, want: %s", got.Date, tc.wantDate)
+			}
+		})
+	}
+}
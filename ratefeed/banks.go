@@ -0,0 +1,75 @@
+package ratefeed
+
+// ECB is the format of the European Central Bank reference rates. Its 90-day and history feeds decode
+// to the rates of their first, latest, day.
+var ECB = XMLFormat{
+	Base:   "EUR",
+	Record: "Envelope/Cube/Cube/Cube",
+	Code:   "@currency",
+	Rate:   "@rate",
+	Date:   "@time",
+}
+
+// CBR is the format of the Central Bank of Russia XML_daily feed, quoted in Rubles per Nominal units.
+var CBR = XMLFormat{
+	Base:             "RUB",
+	Record:           "ValCurs/Valute",
+	Code:             "CharCode",
+	Rate:             "Value",
+	Nominal:          "Nominal",
+	DecimalSeparator: ',',
+	Inverse:          true,
+}
+
+// CNB is the format of the Czech National Bank daily fixing, quoted in Koruna per amount of units.
+var CNB = XMLFormat{
+	Base:             "CZK",
+	Record:           "kurzy/tabulka/radek",
+	Code:             "@kod",
+	Rate:             "@kurz",
+	Nominal:          "@mnozstvi",
+	DecimalSeparator: ',',
+	Inverse:          true,
+}
+
+// NBP is the format of the National Bank of Poland table A of average rates, quoted in Zloty per unit.
+var NBP = XMLFormat{
+	Base:    "PLN",
+	Record:  "ArrayOfExchangeRatesTable/ExchangeRatesTable/Rates/Rate",
+	Code:    "Code",
+	Rate:    "Mid",
+	Inverse: true,
+}
+
+const (
+	centralBankOfRussiaURL  = "https://www.cbr.ru/scripts/XML_daily.asp"
+	czechNationalBankURL    = "https://www.cnb.cz/cs/financni_trhy/devizovy_trh/kurzy_devizoveho_trhu/denni_kurz.xml"
+	nationalBankOfPolandURL = "https://api.nbp.pl/api/exchangerates/tables/A/?format=xml"
+)
+
+// NewCentralBankOfRussia creates a feed of the Central Bank of Russia daily rates.
+// An empty url uses the public feed.
+func NewCentralBankOfRussia(url string) XMLFeed {
+	return NewXMLFeed(orDefault(url, centralBankOfRussiaURL), CBR)
+}
+
+// NewCzechNationalBank creates a feed of the Czech National Bank daily fixing.
+// An empty url uses the public feed.
+func NewCzechNationalBank(url string) XMLFeed {
+	return NewXMLFeed(orDefault(url, czechNationalBankURL), CNB)
+}
+
+// NewNationalBankOfPoland creates a feed of the National Bank of Poland average rates.
+// An empty url uses the public feed.
+func NewNationalBankOfPoland(url string) XMLFeed {
+	return NewXMLFeed(orDefault(url, nationalBankOfPolandURL), NBP)
+}
+
+// orDefault returns value, or fallback when value is empty.
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,113 @@
+package ratefeed
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+func mustParseCurrency(t *testing.T, currency string) money.Currency {
+	t.Helper()
+
+	curr, err := money.ParseCurrency(currency)
+	if err != nil {
+		t.Fatalf("could not parse currency: %s", err.Error())
+	}
+
+	return curr
+}
+
+// newFixtureServer serves a fixture file for every request.
+func newFixtureServer(t *testing.T, fixture string) *httptest.Server {
+	t.Helper()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, fixture)
+	}))
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+func TestBankFeeds_FetchExchangeRate(t *testing.T) {
+	type testCase struct {
+		feed   func(url string) XMLFeed
+		source string
+		target string
+		want   money.ExchangeRate
+		ts     *httptest.Server
+	}
+
+	testCases := map[string]testCase{
+		"Central Bank of Russia": {
+			feed:   NewCentralBankOfRussia,
+			ts:     newFixtureServer(t, "testdata/cbr.xml"),
+			source: "JPY",
+			target: "RUB",
+			want:   0.53654,
+		},
+		"Czech National Bank": {
+			feed:   NewCzechNationalBank,
+			ts:     newFixtureServer(t, "testdata/cnb.xml"),
+			source: "EUR",
+			target: "USD",
+			want:   24.88 / 23.17,
+		},
+		"National Bank of Poland": {
+			feed:   NewNationalBankOfPoland,
+			ts:     newFixtureServer(t, "testdata/nbp.xml"),
+			source: "PLN",
+			target: "EUR",
+			want:   1 / 4.3286,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.feed(tc.ts.URL).FetchExchangeRate(mustParseCurrency(t, tc.source), mustParseCurrency(t, tc.target))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !almostEqual(float64(got), float64(tc.want)) {
+				t.Errorf("got: %g, want: %g", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestXMLFeed_FetchExchangeRate_NotFound(t *testing.T) {
+	ts := newFixtureServer(t, "testdata/nbp.xml")
+
+	_, err := NewNationalBankOfPoland(ts.URL).FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "JPY"))
+	if !errors.Is(err, ErrExchangeRateNotFound) {
+		t.Errorf("got: %v, want: %s", err, ErrExchangeRateNotFound)
+	}
+}
+
+func TestXMLFeed_FetchExchangeRate_ServerError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	_, err := NewCzechNationalBank(ts.URL).FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "CZK"))
+	if !errors.Is(err, ErrServerSide) {
+		t.Errorf("got: %v, want: %s", err, ErrServerSide)
+	}
+}
+
+func TestXMLFeed_FetchExchangeRate_ClientError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	_, err := NewCentralBankOfRussia(ts.URL).FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "RUB"))
+	if !errors.Is(err, ErrClientSide) {
+		t.Errorf("got: %v, want: %s", err, ErrClientSide)
+	}
+}
@@ -0,0 +1,9 @@
+package ratefeed
+
+// feedError defines a sentinel error.
+type feedError string
+
+// feedError implements the error interface.
+func (e feedError) Error() string {
+	return string(e)
+}
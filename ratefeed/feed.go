@@ -0,0 +1,140 @@
+package ratefeed
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+const (
+	// ErrCallingServer returned when an error occurs while calling the server to retrieve exchange rates.
+	ErrCallingServer = feedError("error calling server")
+	// ErrClientSide returned when a malformed client-side requests results in a 400 series error.
+	ErrClientSide = feedError("client-side error occurred")
+	// ErrServerSide returned when a server malfunction occurs and returns a 500 series error.
+	ErrServerSide = feedError("server-side error occurred")
+	// ErrUnknownStatusCode returned when any other status code is returned.
+	ErrUnknownStatusCode = feedError("unknown status code")
+	// ErrExchangeRateNotFound returned when the feed does not quote the requested currencies.
+	ErrExchangeRateNotFound = feedError("exchange rate not found")
+)
+
+const (
+	// errors like 400 or 404
+	clientErrorClass = 4
+	// errors like 500
+	serverErrorClass = 5
+)
+
+// XMLFeed represents a structure that can download an XML rate feed to get exchange rates.
+type XMLFeed struct {
+	url    string
+	format XMLFormat
+}
+
+// NewXMLFeed creates an XMLFeed that downloads the feed at url and decodes it with format.
+func NewXMLFeed(url string, format XMLFormat) XMLFeed {
+	return XMLFeed{url: url, format: format}
+}
+
+// FetchExchangeRate gets the exchange rate for the source to target currency.
+func (f XMLFeed) FetchExchangeRate(source, target money.Currency) (money.ExchangeRate, error) {
+	table, err := f.FetchRateTable()
+	if err != nil {
+		return 0., err
+	}
+
+	return rateFromTable(table, source, target)
+}
+
+// FetchRateTable downloads the feed and decodes every rate it contains.
+func (f XMLFeed) FetchRateTable() (money.RateTable, error) {
+	var table money.RateTable
+
+	err := Get(f.url, func(body io.Reader) error {
+		var err error
+		table, err = f.format.Decode(body)
+		return err
+	})
+
+	return table, err
+}
+
+// Get downloads url and passes the response body of a successful call to read.
+// A failed call returns ErrCallingServer and a response other than 200 OK returns ErrClientSide,
+// ErrServerSide or ErrUnknownStatusCode.
+func Get(url string, read func(io.Reader) error) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrCallingServer, err.Error())
+	}
+
+	return do(req, read)
+}
+
+// do calls the server with req and passes the response body of a successful call to read.
+func do(req *http.Request, read func(io.Reader) error) error {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrCallingServer, redactURL(err).Error())
+	}
+	defer resp.Body.Close()
+
+	if err = checkStatusCode(resp.StatusCode); err != nil {
+		return err
+	}
+
+	return read(resp.Body)
+}
+
+// redactURL removes the query and user information from the URL reported by a failed request,
+// as they may hold an API key that must not end up in logs.
+func redactURL(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+
+	redacted := *urlErr
+	if parsed, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		parsed.User, parsed.RawQuery, parsed.ForceQuery, parsed.Fragment = nil, "", false, ""
+		redacted.URL = parsed.String()
+	} else {
+		redacted.URL = ""
+	}
+
+	return &redacted
+}
+
+// rateFromTable looks up the exchange rate for the source to target currency in a decoded feed.
+func rateFromTable(table money.RateTable, source, target money.Currency) (money.ExchangeRate, error) {
+	rate, err := table.FetchExchangeRate(source, target)
+	if err != nil {
+		return 0., fmt.Errorf("%w: %s", ErrExchangeRateNotFound, err)
+	}
+
+	return rate, nil
+}
+
+// checkStatusCode evaluates an http status code and returns an error if not success.
+func checkStatusCode(statusCode int) error {
+	switch {
+	case statusCode == http.StatusOK:
+		return nil
+	case httpStatusClass(statusCode) == clientErrorClass:
+		return fmt.Errorf("%w, %d", ErrClientSide, statusCode)
+	case httpStatusClass(statusCode) == serverErrorClass:
+		return fmt.Errorf("%w, %d", ErrServerSide, statusCode)
+	default:
+		return fmt.Errorf("%w, %d", ErrUnknownStatusCode, statusCode)
+	}
+}
+
+// httpStatusClass returns the first digit of the status code.
+func httpStatusClass(statusCode int) int {
+	return statusCode / 100
+}
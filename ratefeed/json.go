@@ -0,0 +1,140 @@
+package ratefeed
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+const (
+	errMissingBase = feedError("feed is missing the base currency")
+)
+
+// JSONFeed represents a structure that can read an openexchangerates style JSON rate feed
+// from a url or a local file, e.g. {"base":"USD","timestamp":1718841600,"rates":{"EUR":0.93}}.
+type JSONFeed struct {
+	source string
+	// keyName is the request header or query parameter carrying keyValue.
+	keyName    string
+	keyValue   string
+	keyInQuery bool
+}
+
+// jsonRates is a structure used to model the JSON rate feed.
+type jsonRates struct {
+	Base      string                        `json:"base"`
+	Timestamp int64                         `json:"timestamp"`
+	Rates     map[string]money.ExchangeRate `json:"rates"`
+}
+
+// NewJSONFeed creates a JSONFeed that reads the feed from source.
+// Sources starting with http:// or https:// are downloaded, anything else is read as a file path.
+func NewJSONFeed(source string) JSONFeed {
+	return JSONFeed{source: source}
+}
+
+// WithAPIKeyHeader returns a copy of the feed that sends the API key in the named request header.
+func (f JSONFeed) WithAPIKeyHeader(header, key string) JSONFeed {
+	f.keyName, f.keyValue, f.keyInQuery = header, key, false
+	return f
+}
+
+// WithAPIKeyQuery returns a copy of the feed that sends the API key in the named query parameter.
+func (f JSONFeed) WithAPIKeyQuery(param, key string) JSONFeed {
+	f.keyName, f.keyValue, f.keyInQuery = param, key, true
+	return f
+}
+
+// FetchExchangeRate gets the exchange rate for the source to target currency.
+func (f JSONFeed) FetchExchangeRate(source, target money.Currency) (money.ExchangeRate, error) {
+	table, err := f.FetchRateTable()
+	if err != nil {
+		return 0., err
+	}
+
+	return rateFromTable(table, source, target)
+}
+
+// FetchRateTable reads the feed and decodes every rate it contains.
+func (f JSONFeed) FetchRateTable() (money.RateTable, error) {
+	var table money.RateTable
+
+	read := func(body io.Reader) error {
+		var err error
+		table, err = decodeJSONRates(body)
+		return err
+	}
+
+	if !isURL(f.source) {
+		file, err := os.Open(f.source)
+		if err != nil {
+			return money.RateTable{}, err
+		}
+		defer file.Close()
+
+		err = read(file)
+		return table, err
+	}
+
+	req, err := f.newRequest()
+	if err != nil {
+		return money.RateTable{}, fmt.Errorf("%w: %s", ErrCallingServer, err.Error())
+	}
+
+	err = do(req, read)
+	return table, err
+}
+
+// newRequest creates the request for the feed url with the API key attached.
+func (f JSONFeed) newRequest() (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, f.source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case f.keyValue == "":
+	case f.keyInQuery:
+		query := req.URL.Query()
+		query.Set(f.keyName, f.keyValue)
+		req.URL.RawQuery = query.Encode()
+	default:
+		req.Header.Set(f.keyName, f.keyValue)
+	}
+
+	return req, nil
+}
+
+// decodeJSONRates parses a JSON rate feed into a table of rates against its base currency.
+func decodeJSONRates(body io.Reader) (money.RateTable, error) {
+	var feed jsonRates
+	if err := json.NewDecoder(body).Decode(&feed); err != nil {
+		return money.RateTable{}, fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+	}
+
+	if feed.Base == "" {
+		return money.RateTable{}, fmt.Errorf("%w: %s", ErrUnexpectedFormat, errMissingBase)
+	}
+
+	if len(feed.Rates) == 0 {
+		return money.RateTable{}, fmt.Errorf("%w: %s", ErrUnexpectedFormat, errNoRates)
+	}
+
+	for code, rate := range feed.Rates {
+		if rate <= 0 {
+			return money.RateTable{}, fmt.Errorf("%w: %s: %s", ErrUnexpectedFormat, code, errInvalidRate)
+		}
+	}
+
+	return money.RateTable{Base: feed.Base, Rates: feed.Rates}, nil
+}
+
+// isURL reports whether the source should be downloaded rather than read from disk.
+func isURL(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
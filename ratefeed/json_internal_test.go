@@ -0,0 +1,153 @@
+package ratefeed
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+func TestJSONFeed_FetchExchangeRate_File(t *testing.T) {
+	feed := NewJSONFeed("testdata/rates.json")
+
+	got, err := feed.FetchExchangeRate(mustParseCurrency(t, "EUR"), mustParseCurrency(t, "CAD"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if want := money.ExchangeRate(1.3692 / 0.9307); !almostEqual(float64(got), float64(want)) {
+		t.Errorf("got: %g, want: %g", got, want)
+	}
+}
+
+func TestJSONFeed_FetchExchangeRate_MissingFile(t *testing.T) {
+	feed := NewJSONFeed("testdata/missing.json")
+
+	_, err := feed.FetchExchangeRate(mustParseCurrency(t, "EUR"), mustParseCurrency(t, "CAD"))
+	if err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}
+
+func TestJSONFeed_FetchExchangeRate_APIKey(t *testing.T) {
+	type testCase struct {
+		feed  func(url string) JSONFeed
+		check func(r *http.Request) bool
+	}
+
+	testCases := map[string]testCase{
+		"header": {
+			feed: func(url string) JSONFeed {
+				return NewJSONFeed(url).WithAPIKeyHeader("Authorization", "Token secret")
+			},
+			check: func(r *http.Request) bool {
+				return r.Header.Get("Authorization") == "Token secret" && r.URL.Query().Get("app_id") == ""
+			},
+		},
+		"query parameter": {
+			feed: func(url string) JSONFeed {
+				return NewJSONFeed(url+"?show_alternative=1").WithAPIKeyQuery("app_id", "secret")
+			},
+			check: func(r *http.Request) bool {
+				query := r.URL.Query()
+				return query.Get("app_id") == "secret" && query.Get("show_alternative") == "1"
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tc.check(r) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				http.ServeFile(w, r, "testdata/rates.json")
+			}))
+			defer ts.Close()
+
+			got, err := tc.feed(ts.URL).FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "JPY"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !almostEqual(float64(got), 158.21) {
+				t.Errorf("got: %g, want: %g", got, 158.21)
+			}
+		})
+	}
+}
+
+func TestJSONFeed_FetchExchangeRate_Unauthorized(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	_, err := NewJSONFeed(ts.URL).FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "JPY"))
+	if !errors.Is(err, ErrClientSide) {
+		t.Errorf("got: %v, want: %s", err, ErrClientSide)
+	}
+}
+
+func TestJSONFeed_FetchExchangeRate_UnreachableHidesAPIKey(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	// nothing listens at the address once the server is closed.
+	ts.Close()
+
+	feed := NewJSONFeed(ts.URL+"/latest.json?show_alternative=1").WithAPIKeyQuery("app_id", "SECRET123")
+
+	_, err := feed.FetchExchangeRate(mustParseCurrency(t, "USD"), mustParseCurrency(t, "JPY"))
+	if !errors.Is(err, ErrCallingServer) {
+		t.Fatalf("got: %v, want: %s", err, ErrCallingServer)
+	}
+
+	if strings.Contains(err.Error(), "SECRET123") {
+		t.Errorf("error contains the API key: %s", err.Error())
+	}
+
+	if !strings.Contains(err.Error(), "/latest.json") {
+		t.Errorf("error does not name the feed: %s", err.Error())
+	}
+}
+
+func TestDecodeJSONRates(t *testing.T) {
+	type testCase struct {
+		body    string
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"valid": {
+			body: `{"base": "USD", "timestamp": 1718841600, "rates": {"EUR": 0.93}}`,
+		},
+		"not json": {
+			body:    `<rates/>`,
+			wantErr: ErrUnexpectedFormat,
+		},
+		"missing base": {
+			body:    `{"rates": {"EUR": 0.93}}`,
+			wantErr: ErrUnexpectedFormat,
+		},
+		"no rates": {
+			body:    `{"base": "USD", "rates": {}}`,
+			wantErr: ErrUnexpectedFormat,
+		},
+		"negative rate": {
+			body:    `{"base": "USD", "rates": {"EUR": -0.93}}`,
+			wantErr: ErrUnexpectedFormat,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeJSONRates(strings.NewReader(tc.body))
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got error: %v, want: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
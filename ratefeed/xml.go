@@ -0,0 +1,261 @@
+package ratefeed
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/th3oth3rjak3/MoneyConverter/money"
+)
+
+const (
+	// ErrUnexpectedFormat is returned when a feed body could not be decoded with its format.
+	ErrUnexpectedFormat = feedError("response body was not in the expected format")
+
+	errMissingField = feedError("record is missing a field")
+	errInvalidRate  = feedError("invalid rate")
+	errNoRates      = feedError("feed contains no rates")
+)
+
+// XMLFormat describes where a daily XML rate feed keeps its rates, so that feeds of different
+// central banks can be decoded into the same rate table.
+type XMLFormat struct {
+	// Base is the ISO code of the currency the feed quotes against.
+	Base string
+	// Record is the slash separated path of local element names, starting at the root,
+	// of the element holding a single rate. e.g. "ValCurs/Valute"
+	Record string
+	// Code locates the ISO currency code within a record.
+	// Fields are either "@name" for an attribute of the record element or the name of a child element.
+	Code string
+	// Rate locates the exchange rate within a record.
+	Rate string
+	// Nominal optionally locates the number of units the rate is quoted for. e.g. 100 JPY
+	Nominal string
+	// DecimalSeparator separates the fraction of rates and nominals, '.' when unset.
+	DecimalSeparator rune
+	// Inverse is set when rates are units of the base per unit of the currency (e.g. 90,5 RUB per USD),
+	// rather than units of the currency per unit of the base (e.g. 1,08 USD per EUR).
+	Inverse bool
+	// Date optionally names the attribute of the element enclosing the records that holds their reference date,
+	// e.g. "@time". Feeds grouping the rates of several dates are then decoded up to the end of the first group.
+	Date string
+}
+
+// Decode reads a feed in this format into a table of rates against the base currency.
+func (f XMLFormat) Decode(r io.Reader) (money.RateTable, error) {
+	table, _, err := f.DecodeWithDate(r)
+	return table, err
+}
+
+// DecodeWithDate reads a feed in this format like Decode and also returns the reference date of the rates,
+// as written in the feed. The date is empty when the format has no Date or the feed does not give one.
+func (f XMLFormat) DecodeWithDate(r io.Reader) (money.RateTable, string, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charsetReader
+
+	recordPath := strings.Split(f.Record, "/")
+	groupPath := recordPath[:len(recordPath)-1]
+	rates := make(map[string]money.ExchangeRate)
+
+	var (
+		path   []string
+		record map[string]string
+		text   strings.Builder
+		date   string
+	)
+
+decoding:
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return money.RateTable{}, "", fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			path = append(path, t.Name.Local)
+
+			switch {
+			case f.Date != "" && pathEqual(path, groupPath):
+				if len(rates) > 0 {
+					// the rates of the first date are complete.
+					break decoding
+				}
+
+				date = attribute(t, strings.TrimPrefix(f.Date, "@"))
+			case record == nil && pathEqual(path, recordPath):
+				record = make(map[string]string, len(t.Attr))
+				for _, attr := range t.Attr {
+					record["@"+attr.Name.Local] = attr.Value
+				}
+			case record != nil && len(path) == len(recordPath)+1:
+				text.Reset()
+			}
+		case xml.CharData:
+			if record != nil && len(path) == len(recordPath)+1 {
+				text.Write(t)
+			}
+		case xml.EndElement:
+			switch {
+			case record != nil && len(path) == len(recordPath)+1:
+				record[path[len(path)-1]] = strings.TrimSpace(text.String())
+			case record != nil && len(path) == len(recordPath):
+				code, rate, err := f.parseRecord(record)
+				if err != nil {
+					return money.RateTable{}, "", fmt.Errorf("%w: %s", ErrUnexpectedFormat, err)
+				}
+				rates[code] = rate
+				record = nil
+			}
+
+			path = path[:len(path)-1]
+		}
+	}
+
+	if len(rates) == 0 {
+		return money.RateTable{}, "", fmt.Errorf("%w: %s", ErrUnexpectedFormat, errNoRates)
+	}
+
+	return money.RateTable{Base: f.Base, Rates: rates}, date, nil
+}
+
+// attribute returns the value of the attribute of the element with the local name, or "" when it has none.
+func attribute(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+// parseRecord converts the fields of a record into the number of units of the currency per unit of the base.
+func (f XMLFormat) parseRecord(record map[string]string) (string, money.ExchangeRate, error) {
+	code, found := record[f.Code]
+	if !found {
+		return "", 0, fmt.Errorf("%w: %s", errMissingField, f.Code)
+	}
+
+	value, found := record[f.Rate]
+	if !found {
+		return "", 0, fmt.Errorf("%w: %s", errMissingField, f.Rate)
+	}
+
+	rate, err := f.parseNumber(value)
+	if err != nil {
+		return "", 0, fmt.Errorf("%s: %w", code, err)
+	}
+
+	nominal := 1.
+	if f.Nominal != "" {
+		value, found := record[f.Nominal]
+		if !found {
+			return "", 0, fmt.Errorf("%w: %s", errMissingField, f.Nominal)
+		}
+
+		nominal, err = f.parseNumber(value)
+		if err != nil {
+			return "", 0, fmt.Errorf("%s nominal: %w", code, err)
+		}
+	}
+
+	if f.Inverse {
+		// note: (base per nominal units) / nominal == base per unit, 1 / (base per unit) == units per base
+		return code, money.ExchangeRate(nominal / rate), nil
+	}
+
+	return code, money.ExchangeRate(rate / nominal), nil
+}
+
+// parseNumber parses a positive number written with the format's decimal separator.
+func (f XMLFormat) parseNumber(value string) (float64, error) {
+	separator := f.DecimalSeparator
+	if separator == 0 {
+		separator = '.'
+	}
+
+	normalized := strings.Map(func(r rune) rune {
+		switch {
+		case r == separator:
+			return '.'
+		case r == ' ' || r == '\u00a0':
+			return -1
+		default:
+			return r
+		}
+	}, value)
+
+	number, err := strconv.ParseFloat(normalized, 64)
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("%w: %q", errInvalidRate, value)
+	}
+
+	return number, nil
+}
+
+// pathEqual reports whether the open elements are exactly the expected path.
+func pathEqual(path, expected []string) bool {
+	if len(path) != len(expected) {
+		return false
+	}
+
+	for i := range path {
+		if path[i] != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// charsetReader allows feeds in single byte encodings such as windows-1251 to be decoded.
+// Rate feeds only need ASCII for codes and numbers, so other characters are replaced with U+FFFD.
+func charsetReader(_ string, input io.Reader) (io.Reader, error) {
+	return &asciiReader{input: bufio.NewReader(input)}, nil
+}
+
+// asciiReader passes ASCII bytes through and replaces every other byte with U+FFFD.
+type asciiReader struct {
+	input   io.ByteReader
+	pending []byte
+}
+
+// Read implements the io.Reader interface.
+func (a *asciiReader) Read(p []byte) (int, error) {
+	n := 0
+
+	for n < len(p) {
+		if len(a.pending) > 0 {
+			copied := copy(p[n:], a.pending)
+			a.pending = a.pending[copied:]
+			n += copied
+			continue
+		}
+
+		b, err := a.input.ReadByte()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+
+		if b < utf8.RuneSelf {
+			p[n] = b
+			n++
+		} else {
+			a.pending = []byte(string(utf8.RuneError))
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,258 @@
+package ratefeed
+
+import (
+	"errors"
+	"math"
+	"os"
+	"strings"
+	"testing"
+)
+
+// mustOpen opens a fixture file for the duration of the test.
+func mustOpen(t *testing.T, name string) *os.File {
+	t.Helper()
+
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("could not open fixture: %s", err.Error())
+	}
+
+	t.Cleanup(func() { _ = file.Close() })
+
+	return file
+}
+
+func TestXMLFormatDecode_Fixtures(t *testing.T) {
+	type testCase struct {
+		fixture   string
+		format    XMLFormat
+		wantBase  string
+		wantRates map[string]float64
+	}
+
+	testCases := map[string]testCase{
+		"European Central Bank": {
+			fixture:  "testdata/ecb.xml",
+			format:   ECB,
+			wantBase: "EUR",
+			wantRates: map[string]float64{
+				"USD": 1.0745,
+				"JPY": 170.05,
+				"CAD": 1.47,
+			},
+		},
+		"Central Bank of Russia": {
+			fixture:  "testdata/cbr.xml",
+			format:   CBR,
+			wantBase: "RUB",
+			wantRates: map[string]float64{
+				"USD": 1 / 84.5,
+				"EUR": 1 / 90.6,
+				"JPY": 100 / 53.654,
+			},
+		},
+		"Czech National Bank": {
+			fixture:  "testdata/cnb.xml",
+			format:   CNB,
+			wantBase: "CZK",
+			wantRates: map[string]float64{
+				"EUR": 1 / 24.88,
+				"HUF": 100 / 6.26,
+				"USD": 1 / 23.17,
+			},
+		},
+		"National Bank of Poland": {
+			fixture:  "testdata/nbp.xml",
+			format:   NBP,
+			wantBase: "PLN",
+			wantRates: map[string]float64{
+				"USD": 1 / 4.0335,
+				"EUR": 1 / 4.3286,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.format.Decode(mustOpen(t, tc.fixture))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if got.Base != tc.wantBase {
+				t.Errorf("got base: %s, want: %s", got.Base, tc.wantBase)
+			}
+			if len(got.Rates) != len(tc.wantRates) {
+				t.Errorf("got rates: %v, want: %v", got.Rates, tc.wantRates)
+			}
+			for code, want := range tc.wantRates {
+				if !almostEqual(float64(got.Rates[code]), want) {
+					t.Errorf("got %s: %g, want: %g", code, got.Rates[code], want)
+				}
+			}
+		})
+	}
+}
+
+func TestXMLFormatDecodeWithDate(t *testing.T) {
+	type testCase struct {
+		body      string
+		format    XMLFormat
+		wantRates map[string]float64
+		wantDate  string
+	}
+
+	testCases := map[string]testCase{
+		"single day": {
+			body:      `<Envelope><Cube><Cube time="2024-06-20"><Cube currency="USD" rate="1.0745"/></Cube></Cube></Envelope>`,
+			format:    ECB,
+			wantRates: map[string]float64{"USD": 1.0745},
+			wantDate:  "2024-06-20",
+		},
+		"first of several days": {
+			body: `<Envelope><Cube>` +
+				`<Cube time="2024-05-17"><Cube currency="USD" rate="1.10"/></Cube>` +
+				`<Cube time="2024-05-16"><Cube currency="USD" rate="1.00"/><Cube currency="CAD" rate="1.50"/></Cube>` +
+				`</Cube></Envelope>`,
+			format:    ECB,
+			wantRates: map[string]float64{"USD": 1.1},
+			wantDate:  "2024-05-17",
+		},
+		"no date attribute": {
+			body:      `<Envelope><Cube><Cube><Cube currency="USD" rate="1.0745"/></Cube></Cube></Envelope>`,
+			format:    ECB,
+			wantRates: map[string]float64{"USD": 1.0745},
+		},
+		"format without a date": {
+			body:      `<ValCurs Date="20.06.2024"><Valute><CharCode>USD</CharCode><Nominal>1</Nominal><Value>84,5</Value></Valute></ValCurs>`,
+			format:    CBR,
+			wantRates: map[string]float64{"USD": 1 / 84.5},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, date, err := tc.format.DecodeWithDate(strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if date != tc.wantDate {
+				t.Errorf("got date: %q, want: %q", date, tc.wantDate)
+			}
+			if len(got.Rates) != len(tc.wantRates) {
+				t.Errorf("got rates: %v, want: %v", got.Rates, tc.wantRates)
+			}
+			for code, want := range tc.wantRates {
+				if !almostEqual(float64(got.Rates[code]), want) {
+					t.Errorf("got %s: %g, want: %g", code, got.Rates[code], want)
+				}
+			}
+		})
+	}
+}
+
+func TestXMLFormatDecode_Errors(t *testing.T) {
+	type testCase struct {
+		body    string
+		format  XMLFormat
+		wantErr error
+	}
+
+	testCases := map[string]testCase{
+		"malformed xml": {
+			body:    `<ValCurs><Valute>`,
+			format:  CBR,
+			wantErr: ErrUnexpectedFormat,
+		},
+		"no records": {
+			body:    `<ValCurs></ValCurs>`,
+			format:  CBR,
+			wantErr: errNoRates,
+		},
+		"missing code": {
+			body:    `<ValCurs><Valute><Nominal>1</Nominal><Value>84,5</Value></Valute></ValCurs>`,
+			format:  CBR,
+			wantErr: errMissingField,
+		},
+		"missing nominal": {
+			body:    `<ValCurs><Valute><CharCode>USD</CharCode><Value>84,5</Value></Valute></ValCurs>`,
+			format:  CBR,
+			wantErr: errMissingField,
+		},
+		"wrong decimal separator": {
+			body:    `<ValCurs><Valute><CharCode>USD</CharCode><Nominal>1</Nominal><Value>84.500,1</Value></Valute></ValCurs>`,
+			format:  CBR,
+			wantErr: errInvalidRate,
+		},
+		"zero rate": {
+			body:    `<kurzy><tabulka><radek kod="USD" mnozstvi="1" kurz="0"/></tabulka></kurzy>`,
+			format:  CNB,
+			wantErr: errInvalidRate,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.format.Decode(strings.NewReader(tc.body))
+			if !errors.Is(err, ErrUnexpectedFormat) {
+				t.Errorf("got error: %v, want: %s", err, ErrUnexpectedFormat)
+			}
+			if !strings.Contains(err.Error(), tc.wantErr.Error()) {
+				t.Errorf("got error: %v, want: %s", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestXMLFormatParseNumber(t *testing.T) {
+	type testCase struct {
+		separator rune
+		value     string
+		want      float64
+		wantErr   error
+	}
+
+	testCases := map[string]testCase{
+		"default separator": {
+			value: "1.0745",
+			want:  1.0745,
+		},
+		"comma separator": {
+			separator: ',',
+			value:     "84,5",
+			want:      84.5,
+		},
+		"grouping spaces": {
+			separator: ',',
+			value:     "1 234,5",
+			want:      1234.5,
+		},
+		"negative": {
+			value:   "-1",
+			wantErr: errInvalidRate,
+		},
+		"not a number": {
+			value:   "n/a",
+			wantErr: errInvalidRate,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := XMLFormat{DecimalSeparator: tc.separator}.parseNumber(tc.value)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("got error: %v, want: %v", err, tc.wantErr)
+			}
+			if !almostEqual(got, tc.want) {
+				t.Errorf("got: %g, want: %g", got, tc.want)
+			}
+		})
+	}
+}
+
+// almostEqual compares two floats to 9 decimal places
+func almostEqual(f1, f2 float64) bool {
+	const threshold float64 = 1e-9
+	return math.Abs(f1-f2) < threshold
+}